@@ -1,6 +1,10 @@
 package discord
 
 import (
+	"net/http"
+	"strings"
+	"time"
+
 	"github.com/bwmarrin/discordgo"
 )
 
@@ -8,6 +12,17 @@ type Config struct {
 	Token    string
 	ClientID string
 	Secret   string
+	// BaseURL overrides the Discord endpoint (`https://discord.com/`) that
+	// every REST call is made against. It is meant for pointing the provider
+	// at a fake API during tests.
+	BaseURL string
+	// MaxRetries is how many times a rate limited or failed request is
+	// retried before giving up.
+	MaxRetries int
+	// RequestTimeout limits how long a single attempt of a request may take.
+	RequestTimeout time.Duration
+	// RateLimitStrategy is either `wait` or `fail`, see retryTransport.
+	RateLimitStrategy string
 }
 
 type Context struct {
@@ -17,10 +32,50 @@ type Context struct {
 
 func (c *Config) Client(version string) (*Context, error) {
 	session, err := discordgo.New(c.Token)
-	session.UserAgent = "discord-terraform/" + version
 	if err != nil {
 		return nil, err
 	}
+	session.UserAgent = "discord-terraform/" + version
+
+	// Retries are left to retryTransport, which knows which requests are safe
+	// to send again.
+	session.MaxRestRetries = 0
+	session.ShouldRetryOnRateLimit = false
+	transport := newRetryTransport(c.MaxRetries, c.RequestTimeout, c.RateLimitStrategy)
+	transport.limiter = session.Ratelimiter
+	session.Client = &http.Client{Transport: transport}
+
+	if c.BaseURL != "" {
+		setDiscordEndpoint(c.BaseURL)
+	}
 
 	return &Context{Config: c, Session: session}, nil
 }
+
+// setDiscordEndpoint re-points discordgo's endpoint variables at base.
+// discordgo derives every endpoint from a handful of package level strings at
+// init time, so those have to be rebuilt here; the endpoint functions read
+// them lazily and pick the new values up on their own.
+func setDiscordEndpoint(base string) {
+	if !strings.HasSuffix(base, "/") {
+		base += "/"
+	}
+
+	discordgo.EndpointDiscord = base
+	discordgo.EndpointAPI = discordgo.EndpointDiscord + "api/v" + discordgo.APIVersion + "/"
+	discordgo.EndpointGuilds = discordgo.EndpointAPI + "guilds/"
+	discordgo.EndpointChannels = discordgo.EndpointAPI + "channels/"
+	discordgo.EndpointUsers = discordgo.EndpointAPI + "users/"
+	discordgo.EndpointGateway = discordgo.EndpointAPI + "gateway"
+	discordgo.EndpointGatewayBot = discordgo.EndpointGateway + "/bot"
+	discordgo.EndpointWebhooks = discordgo.EndpointAPI + "webhooks/"
+	discordgo.EndpointStickers = discordgo.EndpointAPI + "stickers/"
+	discordgo.EndpointStageInstances = discordgo.EndpointAPI + "stage-instances"
+	discordgo.EndpointVoice = discordgo.EndpointAPI + "/voice/"
+	discordgo.EndpointVoiceRegions = discordgo.EndpointVoice + "regions"
+	discordgo.EndpointNitroStickersPacks = discordgo.EndpointAPI + "/sticker-packs"
+	discordgo.EndpointGuildCreate = discordgo.EndpointAPI + "guilds"
+	discordgo.EndpointApplications = discordgo.EndpointAPI + "applications"
+	discordgo.EndpointOAuth2 = discordgo.EndpointAPI + "oauth2/"
+	discordgo.EndpointOAuth2Applications = discordgo.EndpointOAuth2 + "applications"
+}
@@ -0,0 +1,65 @@
+package discord
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func testFakeSession(t *testing.T) *discordgo.Session {
+	if fake == nil {
+		t.Skip("the fake Discord API is only used when DISCORD_TOKEN is not set")
+	}
+
+	config := &Config{Token: "Bot " + fakeDiscordToken, BaseURL: fake.URL}
+	client, err := config.Client("dev")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	return client.Session
+}
+
+func TestConfigClientBaseURL(t *testing.T) {
+	session := testFakeSession(t)
+
+	server, err := session.Guild(fake.serverId)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if server.Name != "Discord Terraform Test Server" {
+		t.Errorf("unexpected server name %q", server.Name)
+	}
+
+	channel, err := session.GuildChannelCreateComplex(fake.serverId, discordgo.GuildChannelCreateData{
+		Name:  "terraform-test",
+		Type:  discordgo.ChannelTypeGuildText,
+		Topic: "topic",
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	name := "terraform-test-renamed"
+	if _, err := session.ChannelEdit(channel.ID, &discordgo.ChannelEdit{Name: name}); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if channel, err = session.Channel(channel.ID); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if channel.Name != name || channel.Topic != "topic" || channel.GuildID != fake.serverId {
+		t.Errorf("unexpected channel %+v", channel)
+	}
+
+	if _, err := session.ChannelDelete(channel.ID); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	_, err = session.Channel(channel.ID)
+	var restErr *discordgo.RESTError
+	if !errors.As(err, &restErr) || restErr.Response.StatusCode != http.StatusNotFound || restErr.Message.Code != discordgo.ErrCodeUnknownChannel {
+		t.Errorf("expected an Unknown Channel error, got %v", err)
+	}
+}
@@ -0,0 +1,121 @@
+package discord
+
+import (
+	"fmt"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/net/context"
+)
+
+type bansDataSource struct {
+	frameworkDataSource
+}
+
+type bansDataSourceModel struct {
+	ServerId types.String   `tfsdk:"server_id"`
+	Bans     []banDataModel `tfsdk:"bans"`
+	UserIds  types.Set      `tfsdk:"user_ids"`
+	Id       types.String   `tfsdk:"id"`
+}
+
+type banDataModel struct {
+	UserId   types.String `tfsdk:"user_id"`
+	Username types.String `tfsdk:"username"`
+	Reason   types.String `tfsdk:"reason"`
+}
+
+func dataSourceDiscordBans() datasource.DataSource {
+	return &bansDataSource{}
+}
+
+func (d *bansDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bans"
+}
+
+func (d *bansDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches all bans in a server.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The server ID to fetch the bans of.",
+			},
+			"bans": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The bans in the server.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"user_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The banned user's ID.",
+						},
+						"username": schema.StringAttribute{
+							Computed:    true,
+							Description: "The banned user's username.",
+						},
+						"reason": schema.StringAttribute{
+							Computed:    true,
+							Description: "The reason for the ban.",
+						},
+					},
+				},
+			},
+			"user_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "IDs of all banned users, for comparing bans between servers.",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the server.",
+			},
+		},
+	}
+}
+
+func (d *bansDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data bansDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverId := data.ServerId.ValueString()
+	var bans []*discordgo.GuildBan
+	after := ""
+	// Fetch all bans, with pagination
+	for {
+		page, err := d.client.GuildBans(serverId, 1000, "", after, discordgo.WithContext(ctx))
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to fetch bans for %s", serverId), err.Error())
+			return
+		}
+		bans = append(bans, page...)
+		if len(page) < 1000 {
+			break
+		}
+		after = page[len(page)-1].User.ID
+	}
+
+	data.Bans = make([]banDataModel, 0, len(bans))
+	userIds := make([]string, 0, len(bans))
+	for _, ban := range bans {
+		data.Bans = append(data.Bans, banDataModel{
+			UserId:   types.StringValue(ban.User.ID),
+			Username: types.StringValue(ban.User.Username),
+			Reason:   types.StringValue(ban.Reason),
+		})
+		userIds = append(userIds, ban.User.ID)
+	}
+
+	var diags diag.Diagnostics
+	data.Id = types.StringValue(serverId)
+	data.UserIds, diags = setOfStrings(ctx, userIds)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
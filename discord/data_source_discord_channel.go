@@ -5,82 +5,278 @@ import (
 	"fmt"
 
 	"github.com/bwmarrin/discordgo"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-func dataSourceDiscordChannel() *schema.Resource {
-	return &schema.Resource{
-		ReadContext: dataSourceDiscordChannelRead,
-		Description: "Fetches a channel's information.",
-		Schema: map[string]*schema.Schema{
-			"server_id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "ID of server this channel is in.",
-			},
-			"channel_id": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "The ID of the channel.",
-			},
-			"name": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Name of the channel.",
-			},
-			"position": {
-				Type:        schema.TypeInt,
-				Default:     1,
-				Optional:    true,
-				Description: "Position of the channel, `0`-indexed.",
-				ValidateFunc: func(val interface{}, key string) (warns []string, errors []error) {
-					v := val.(int)
-
-					if v < 0 {
-						errors = append(errors, fmt.Errorf("position must be greater than 0, got: %d", v))
-					}
-
-					return
+type channelDataSource struct {
+	frameworkDataSource
+}
+
+type channelDataSourceModel struct {
+	ServerId             types.String                   `tfsdk:"server_id"`
+	ChannelId            types.String                   `tfsdk:"channel_id"`
+	Name                 types.String                   `tfsdk:"name"`
+	Type                 types.String                   `tfsdk:"type"`
+	CategoryId           types.String                   `tfsdk:"category_id"`
+	Position             types.Int64                    `tfsdk:"position"`
+	Topic                types.String                   `tfsdk:"topic"`
+	NSFW                 types.Bool                     `tfsdk:"nsfw"`
+	Bitrate              types.Int64                    `tfsdk:"bitrate"`
+	UserLimit            types.Int64                    `tfsdk:"user_limit"`
+	Flags                types.Int64                    `tfsdk:"flags"`
+	PermissionOverwrites []permissionOverwriteDataModel `tfsdk:"permission_overwrites"`
+	Id                   types.String                   `tfsdk:"id"`
+}
+
+// channelDataModel is a channel the channel data sources export.
+type channelDataModel struct {
+	ChannelId            types.String                   `tfsdk:"channel_id"`
+	Name                 types.String                   `tfsdk:"name"`
+	Type                 types.String                   `tfsdk:"type"`
+	CategoryId           types.String                   `tfsdk:"category_id"`
+	Position             types.Int64                    `tfsdk:"position"`
+	Topic                types.String                   `tfsdk:"topic"`
+	NSFW                 types.Bool                     `tfsdk:"nsfw"`
+	Bitrate              types.Int64                    `tfsdk:"bitrate"`
+	UserLimit            types.Int64                    `tfsdk:"user_limit"`
+	Flags                types.Int64                    `tfsdk:"flags"`
+	PermissionOverwrites []permissionOverwriteDataModel `tfsdk:"permission_overwrites"`
+}
+
+type permissionOverwriteDataModel struct {
+	Type        types.String `tfsdk:"type"`
+	OverwriteId types.String `tfsdk:"overwrite_id"`
+	Allow       types.Int64  `tfsdk:"allow"`
+	Deny        types.Int64  `tfsdk:"deny"`
+}
+
+func dataSourceDiscordChannel() datasource.DataSource {
+	return &channelDataSource{}
+}
+
+func (d *channelDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel"
+}
+
+func (d *channelDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := getChannelDataAttributes()
+	attributes["server_id"] = schema.StringAttribute{
+		Required:    true,
+		Description: "ID of server this channel is in.",
+	}
+	attributes["channel_id"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The ID of the channel. Either this or `name` is required.",
+	}
+	attributes["name"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "Name of the channel. Either this or `channel_id` is required.",
+	}
+	attributes["type"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Validators:  []validator.String{stringvalidator.OneOf(channelTypes...)},
+		Description: "Type of the channel, one of `text`, `voice`, `news`, `category`, `stage`, `forum` or `media`. Narrows down channels with the same name.",
+	}
+	attributes["category_id"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "ID of the category the channel is in. Narrows down channels with the same name.",
+	}
+	// position used to be an argument, which was never used to look channels up.
+	attributes["position"] = schema.Int64Attribute{
+		Optional:           true,
+		Computed:           true,
+		DeprecationMessage: "position is only exported, setting it has no effect.",
+		Description:        "Position of the channel, `0`-indexed.",
+	}
+	attributes["id"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "The ID of the channel.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Fetches a channel's information. Fails unless exactly one channel matches.",
+		Attributes:  attributes,
+	}
+}
+
+func (d *channelDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.AtLeastOneOf(path.MatchRoot("channel_id"), path.MatchRoot("name")),
+	}
+}
+
+// getChannelDataAttributes returns the attributes that the channel data
+// sources export for every channel.
+func getChannelDataAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"position": schema.Int64Attribute{
+			Computed:    true,
+			Description: "Position of the channel, `0`-indexed.",
+		},
+		"topic": schema.StringAttribute{
+			Computed:    true,
+			Description: "Topic of the channel.",
+		},
+		"nsfw": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the channel is NSFW.",
+		},
+		"bitrate": schema.Int64Attribute{
+			Computed:    true,
+			Description: "Bitrate of the channel.",
+		},
+		"user_limit": schema.Int64Attribute{
+			Computed:    true,
+			Description: "User limit of the channel.",
+		},
+		"flags": schema.Int64Attribute{
+			Computed:    true,
+			Description: "Flags of the channel.",
+		},
+		"permission_overwrites": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "Permission overwrites of the channel.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Computed:    true,
+						Description: "Type of the overwrite, either `role` or `user`.",
+					},
+					"overwrite_id": schema.StringAttribute{
+						Computed:    true,
+						Description: "ID of the role or user.",
+					},
+					"allow": schema.Int64Attribute{
+						Computed:    true,
+						Description: "Permission bits allowed by the overwrite.",
+					},
+					"deny": schema.Int64Attribute{
+						Computed:    true,
+						Description: "Permission bits denied by the overwrite.",
+					},
 				},
 			},
 		},
 	}
 }
 
-func dataSourceDiscordChannelRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	client := m.(*Context).Session
-
-	serverID := d.Get("server_id").(string)
-	channelID := d.Get("channel_id").(string)
-	channelName := d.Get("name").(string)
-
-	var channel *discordgo.Channel
-
-	if channelID != "" {
-		channel, _ = client.Channel(channelID)
-	} else if channelName != "" {
-		channels, _ := client.GuildChannels(serverID)
-		for _, c := range channels {
-			if c.Name == channelName {
-				channel = c
-				break
-			}
+// filterChannels returns the channels that match name, channelType and
+// categoryId, which match any channel when they're empty.
+func filterChannels(channels []*discordgo.Channel, name string, channelType string, categoryId string) []*discordgo.Channel {
+	res := make([]*discordgo.Channel, 0)
+	for _, c := range channels {
+		if name != "" && c.Name != name {
+			continue
 		}
+		if t, _ := getTextChannelType(c.Type); channelType != "" && t != channelType {
+			continue
+		}
+		if categoryId != "" && c.ParentID != categoryId {
+			continue
+		}
+		res = append(res, c)
+	}
+
+	return res
+}
+
+func unbuildChannel(channel *discordgo.Channel) channelDataModel {
+	var channelType string
+	if t, ok := getTextChannelType(channel.Type); ok {
+		channelType = t
+	}
+
+	overwrites := make([]permissionOverwriteDataModel, 0, len(channel.PermissionOverwrites))
+	for _, o := range channel.PermissionOverwrites {
+		overwrites = append(overwrites, permissionOverwriteDataModel{
+			Type:        types.StringValue(getTextChannelPermissionType(o.Type)),
+			OverwriteId: types.StringValue(o.ID),
+			Allow:       types.Int64Value(o.Allow),
+			Deny:        types.Int64Value(o.Deny),
+		})
+	}
+
+	return channelDataModel{
+		ChannelId:            types.StringValue(channel.ID),
+		Name:                 types.StringValue(channel.Name),
+		Type:                 types.StringValue(channelType),
+		CategoryId:           types.StringValue(channel.ParentID),
+		Position:             types.Int64Value(int64(channel.Position)),
+		Topic:                types.StringValue(channel.Topic),
+		NSFW:                 types.BoolValue(channel.NSFW),
+		Bitrate:              types.Int64Value(int64(channel.Bitrate)),
+		UserLimit:            types.Int64Value(int64(channel.UserLimit)),
+		Flags:                types.Int64Value(int64(channel.Flags)),
+		PermissionOverwrites: overwrites,
+	}
+}
+
+func (d *channelDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data channelDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverId := data.ServerId.ValueString()
+	channelId := data.ChannelId.ValueString()
+	name := data.Name.ValueString()
+
+	var channels []*discordgo.Channel
+	if channelId != "" {
+		channel, err := d.client.Channel(channelId, discordgo.WithContext(ctx))
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to fetch channel %s", channelId), err.Error())
+			return
+		}
+		if channel.GuildID != serverId {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to fetch channel %s", channelId), fmt.Sprintf("The channel is not in server %s", serverId))
+			return
+		}
+		channels = []*discordgo.Channel{channel}
 	} else {
-		return diag.Errorf("Either channel_id or channel name must be provided")
+		var err error
+		channels, err = d.client.GuildChannels(serverId, discordgo.WithContext(ctx))
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to fetch channels of server %s", serverId), err.Error())
+			return
+		}
 	}
 
-	if channel == nil {
-		return diag.Errorf("Channel with ID %s or name %s not found", channelID, channelName)
+	channels = filterChannels(channels, name, data.Type.ValueString(), data.CategoryId.ValueString())
+	if len(channels) == 0 {
+		resp.Diagnostics.AddError("Failed to find channel", fmt.Sprintf("No channel has the ID %s or the name %s", channelId, name))
+		return
+	}
+	if len(channels) > 1 {
+		resp.Diagnostics.AddError("Failed to find channel", fmt.Sprintf("%d channels are named %s, narrow them down with type or category_id", len(channels), name))
+		return
 	}
 
-	d.SetId(channel.ID)
-	d.Set("server_id", channel.GuildID)
-	d.Set("channel_id", channel.ID)
-	d.Set("name", channel.Name)
-	d.Set("position", channel.Position)
+	channel := unbuildChannel(channels[0])
+	data.Id = channel.ChannelId
+	data.ServerId = types.StringValue(channels[0].GuildID)
+	data.ChannelId = channel.ChannelId
+	data.Name = channel.Name
+	data.Type = channel.Type
+	data.CategoryId = channel.CategoryId
+	data.Position = channel.Position
+	data.Topic = channel.Topic
+	data.NSFW = channel.NSFW
+	data.Bitrate = channel.Bitrate
+	data.UserLimit = channel.UserLimit
+	data.Flags = channel.Flags
+	data.PermissionOverwrites = channel.PermissionOverwrites
 
-	return diags
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -0,0 +1,59 @@
+package discord
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasourceDiscordChannel(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	if testServerID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID envvar must be set for acceptance tests")
+	}
+
+	name := "data.discord_channel.example"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasourceDiscordChannel(testServerID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "server_id", testServerID),
+					resource.TestCheckResourceAttrPair(name, "channel_id", "discord_text_channel.example", "id"),
+					resource.TestCheckResourceAttr(name, "name", "terraform-data-channel"),
+					resource.TestCheckResourceAttr(name, "type", "text"),
+					resource.TestCheckResourceAttr(name, "topic", "Testing channel data source"),
+					resource.TestCheckResourceAttr(name, "nsfw", "false"),
+					resource.TestCheckResourceAttr(name, "category_id", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatasourceDiscordChannel(serverId string) string {
+	return fmt.Sprintf(`
+	resource "discord_text_channel" "example" {
+	  server_id = "%[1]s"
+	  name = "terraform-data-channel"
+	  topic = "Testing channel data source"
+	  sync_perms_with_category = false
+	}
+
+	resource "discord_voice_channel" "example" {
+	  server_id = "%[1]s"
+	  name = "terraform-data-channel"
+	  sync_perms_with_category = false
+	}
+
+	data "discord_channel" "example" {
+	  server_id = "%[1]s"
+	  name = "terraform-data-channel"
+	  type = "text"
+	  depends_on = [discord_text_channel.example, discord_voice_channel.example]
+	}`, serverId)
+}
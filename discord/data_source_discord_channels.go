@@ -0,0 +1,112 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type channelsDataSource struct {
+	frameworkDataSource
+}
+
+type channelsDataSourceModel struct {
+	ServerId   types.String       `tfsdk:"server_id"`
+	Name       types.String       `tfsdk:"name"`
+	Type       types.String       `tfsdk:"type"`
+	CategoryId types.String       `tfsdk:"category_id"`
+	Channels   []channelDataModel `tfsdk:"channels"`
+	Id         types.String       `tfsdk:"id"`
+}
+
+func dataSourceDiscordChannels() datasource.DataSource {
+	return &channelsDataSource{}
+}
+
+func (d *channelsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channels"
+}
+
+func (d *channelsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	channelAttributes := getChannelDataAttributes()
+	channelAttributes["channel_id"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "The ID of the channel.",
+	}
+	channelAttributes["name"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "Name of the channel.",
+	}
+	channelAttributes["type"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "Type of the channel.",
+	}
+	channelAttributes["category_id"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "ID of the category the channel is in.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Fetches the channels of a server.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The server ID to search for.",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list channels with this name.",
+			},
+			"type": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.OneOf(channelTypes...)},
+				Description: "Only list channels of this type, one of `text`, `voice`, `news`, `category`, `stage`, `forum` or `media`.",
+			},
+			"category_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list channels in this category.",
+			},
+			"channels": schema.ListNestedAttribute{
+				Computed:     true,
+				Description:  "The matching channels, sorted by position.",
+				NestedObject: schema.NestedAttributeObject{Attributes: channelAttributes},
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the server.",
+			},
+		},
+	}
+}
+
+func (d *channelsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data channelsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverId := data.ServerId.ValueString()
+	channels, err := d.client.GuildChannels(serverId, discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to fetch channels of server %s", serverId), err.Error())
+		return
+	}
+
+	channels = filterChannels(channels, data.Name.ValueString(), data.Type.ValueString(), data.CategoryId.ValueString())
+	sortChannels(channels)
+
+	data.Id = types.StringValue(serverId)
+	data.Channels = make([]channelDataModel, 0, len(channels))
+	for _, c := range channels {
+		data.Channels = append(data.Channels, unbuildChannel(c))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,62 @@
+package discord
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasourceDiscordChannels(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	if testServerID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID envvar must be set for acceptance tests")
+	}
+
+	name := "data.discord_channels.example"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasourceDiscordChannels(testServerID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "server_id", testServerID),
+					resource.TestCheckResourceAttr(name, "channels.#", "2"),
+					resource.TestCheckResourceAttrPair(name, "channels.0.channel_id", "discord_text_channel.first", "id"),
+					resource.TestCheckResourceAttrPair(name, "channels.1.channel_id", "discord_voice_channel.second", "id"),
+					resource.TestCheckResourceAttr(name, "channels.1.type", "voice"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatasourceDiscordChannels(serverId string) string {
+	return fmt.Sprintf(`
+	resource "discord_category_channel" "example" {
+	  server_id = "%[1]s"
+	  name = "terraform-data-channels"
+	}
+
+	resource "discord_text_channel" "first" {
+	  server_id = "%[1]s"
+	  name = "first"
+	  category = discord_category_channel.example.id
+	  position = 0
+	}
+
+	resource "discord_voice_channel" "second" {
+	  server_id = "%[1]s"
+	  name = "second"
+	  category = discord_category_channel.example.id
+	  position = 1
+	}
+
+	data "discord_channels" "example" {
+	  server_id = "%[1]s"
+	  category_id = discord_category_channel.example.id
+	  depends_on = [discord_text_channel.first, discord_voice_channel.second]
+	}`, serverId)
+}
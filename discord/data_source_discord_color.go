@@ -2,33 +2,52 @@ package discord
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 	"strings"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"gopkg.in/go-playground/colors.v1"
 )
 
-func dataSourceDiscordColor() *schema.Resource {
-	return &schema.Resource{
-		ReadContext: dataSourceDiscordColorRead,
+type colorDataSource struct{}
+
+type colorDataSourceModel struct {
+	Hex types.String `tfsdk:"hex"`
+	RGB types.String `tfsdk:"rgb"`
+	Dec types.Int64  `tfsdk:"dec"`
+	Id  types.String `tfsdk:"id"`
+}
+
+func dataSourceDiscordColor() datasource.DataSource {
+	return &colorDataSource{}
+}
+
+func (d *colorDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_color"
+}
+
+func (d *colorDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
 		Description: "A simple helper to get the integer representation of a hex or RGB color.",
-		Schema: map[string]*schema.Schema{
-			"hex": {
-				ExactlyOneOf: []string{"hex", "rgb"},
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The hex color code. Either this or `rgb` is required.",
+		Attributes: map[string]schema.Attribute{
+			"hex": schema.StringAttribute{
+				Optional:    true,
+				Description: "The hex color code. Either this or `rgb` is required.",
 			},
-			"rgb": {
-				ExactlyOneOf: []string{"hex", "rgb"},
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The RGB color, in format: `rgb(R, G, B)`. Either this or `hex` is required.",
+			"rgb": schema.StringAttribute{
+				Optional:    true,
+				Description: "The RGB color, in format: `rgb(R, G, B)`. Either this or `hex` is required.",
 			},
-			"dec": {
-				Type:        schema.TypeInt,
+			"dec": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The integer representation of the passed color.",
+			},
+			"id": schema.StringAttribute{
 				Computed:    true,
 				Description: "The integer representation of the passed color.",
 			},
@@ -36,6 +55,12 @@ func dataSourceDiscordColor() *schema.Resource {
 	}
 }
 
+func (d *colorDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("hex"), path.MatchRoot("rgb")),
+	}
+}
+
 func ConvertToInt(hex string) (int64, error) {
 	hex = strings.Replace(hex, "0x", "", 1)
 	hex = strings.Replace(hex, "0X", "", 1)
@@ -44,31 +69,39 @@ func ConvertToInt(hex string) (int64, error) {
 	return strconv.ParseInt(hex, 16, 64)
 }
 
-func dataSourceDiscordColorRead(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
+func (d *colorDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data colorDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	var hex string
-	if v, ok := d.GetOk("hex"); ok {
-		if clr, err := colors.ParseHEX(v.(string)); err != nil {
-			return diag.Errorf("Failed to parse hex %s: %s", v.(string), err.Error())
-		} else {
-			hex = clr.String()
+	if !data.Hex.IsNull() {
+		clr, err := colors.ParseHEX(data.Hex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to parse hex %s", data.Hex.ValueString()), err.Error())
+			return
 		}
+		hex = clr.String()
 	}
-	if v, ok := d.GetOk("rgb"); ok {
-		if clr, err := colors.ParseRGB(v.(string)); err != nil {
-			return diag.Errorf("Failed to parse rgb %s: %s", v.(string), err.Error())
-		} else {
-			hex = clr.ToHEX().String()
+	if !data.RGB.IsNull() {
+		clr, err := colors.ParseRGB(data.RGB.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to parse rgb %s", data.RGB.ValueString()), err.Error())
+			return
 		}
+		hex = clr.ToHEX().String()
 	}
 
-	if intColor, err := ConvertToInt(hex); err != nil {
-		return diag.Errorf("Failed to parse hex %s: %s", hex, err.Error())
-	} else {
-		d.SetId(strconv.Itoa(int(intColor)))
-		d.Set("dec", int(intColor))
-
-		return diags
+	intColor, err := ConvertToInt(hex)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to parse hex %s", hex), err.Error())
+		return
 	}
+
+	data.Id = types.StringValue(strconv.Itoa(int(intColor)))
+	data.Dec = types.Int64Value(intColor)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
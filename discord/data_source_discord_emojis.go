@@ -0,0 +1,77 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type emojisDataSource struct {
+	frameworkDataSource
+}
+
+type emojisDataSourceModel struct {
+	ServerId types.String `tfsdk:"server_id"`
+	Emojis   types.Map    `tfsdk:"emojis"`
+	Id       types.String `tfsdk:"id"`
+}
+
+func dataSourceDiscordEmojis() datasource.DataSource {
+	return &emojisDataSource{}
+}
+
+func (d *emojisDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_emojis"
+}
+
+func (d *emojisDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the custom emoji of a server.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The server ID to search for.",
+			},
+			"emojis": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Map of emoji names to their IDs.",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the server.",
+			},
+		},
+	}
+}
+
+func (d *emojisDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data emojisDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverId := data.ServerId.ValueString()
+	emojis, err := d.client.GuildEmojis(serverId, discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to fetch emojis for %s", serverId), err.Error())
+		return
+	}
+
+	res := make(map[string]string, len(emojis))
+	for _, emoji := range emojis {
+		res[emoji.Name] = emoji.ID
+	}
+
+	emojiMap, diags := types.MapValueFrom(ctx, types.StringType, res)
+	resp.Diagnostics.Append(diags...)
+	data.Id = types.StringValue(serverId)
+	data.Emojis = emojiMap
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
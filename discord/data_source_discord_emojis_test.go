@@ -0,0 +1,45 @@
+package discord
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasourceDiscordEmojis(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	if testServerID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID envvar must be set for acceptance tests")
+	}
+
+	name := "data.discord_emojis.example"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasourceDiscordEmojis(testServerID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "server_id", testServerID),
+					resource.TestCheckResourceAttrPair(name, "emojis.terraform_data_emoji", "discord_emoji.example", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatasourceDiscordEmojis(serverId string) string {
+	return fmt.Sprintf(`
+	resource "discord_emoji" "example" {
+	  server_id = "%[1]s"
+	  name = "terraform_data_emoji"
+	  image = "data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAQAAAC1HAwCAAAAC0lEQVR42mNkYAAAAAYAAjCB0C8AAAAASUVORK5CYII="
+	}
+
+	data "discord_emojis" "example" {
+	  server_id = discord_emoji.example.server_id
+	  depends_on = [discord_emoji.example]
+	}`, serverId)
+}
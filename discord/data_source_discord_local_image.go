@@ -2,42 +2,67 @@ package discord
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/polds/imgbase64"
 )
 
-func dataSourceDiscordLocalImage() *schema.Resource {
-	return &schema.Resource{
-		ReadContext: dataSourceDiscordLocalImageRead,
+type localImageDataSource struct{}
+
+type localImageDataSourceModel struct {
+	File    types.String `tfsdk:"file"`
+	DataURI types.String `tfsdk:"data_uri"`
+	Id      types.String `tfsdk:"id"`
+}
+
+func dataSourceDiscordLocalImage() datasource.DataSource {
+	return &localImageDataSource{}
+}
+
+func (d *localImageDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_local_image"
+}
+
+func (d *localImageDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
 		Description: "A simple helper to get data URI of a local image.",
-		Schema: map[string]*schema.Schema{
-			"file": {
-				Type:        schema.TypeString,
+		Attributes: map[string]schema.Attribute{
+			"file": schema.StringAttribute{
 				Required:    true,
 				Description: "The path to the file to process.",
 			},
-			"data_uri": {
-				Type:        schema.TypeString,
+			"data_uri": schema.StringAttribute{
 				Computed:    true,
 				Description: "The data URI of the `file`.",
 			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The hash of the data URI.",
+			},
 		},
 	}
 }
 
-func dataSourceDiscordLocalImageRead(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	file := d.Get("file").(string)
-
-	if img, err := imgbase64.FromLocal(file); err != nil {
-		return diag.Errorf("Failed to process %s: %s", file, err.Error())
-	} else {
-		d.Set("data_uri", img)
-		d.SetId(strconv.Itoa(Hashcode(d.Get("data_uri").(string))))
+func (d *localImageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data localImageDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-		return diags
+	file := data.File.ValueString()
+	img, err := imgbase64.FromLocal(file)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to process %s", file), err.Error())
+		return
 	}
+
+	data.DataURI = types.StringValue(img)
+	data.Id = types.StringValue(strconv.Itoa(Hashcode(img)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
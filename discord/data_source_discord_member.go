@@ -4,74 +4,89 @@ import (
 	"fmt"
 
 	"github.com/bwmarrin/discordgo"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"golang.org/x/net/context"
 )
 
-func dataSourceDiscordMember() *schema.Resource {
-	return &schema.Resource{
-		ReadContext: dataSourceMemberRead,
-		Description: "Fetches a member's information from a server.",
+type memberDataSource struct {
+	frameworkDataSource
+}
+
+type memberDataSourceModel struct {
+	ServerId      types.String `tfsdk:"server_id"`
+	UserId        types.String `tfsdk:"user_id"`
+	Username      types.String `tfsdk:"username"`
+	Discriminator types.String `tfsdk:"discriminator"`
+	Id            types.String `tfsdk:"id"`
+	JoinedAt      types.String `tfsdk:"joined_at"`
+	PremiumSince  types.String `tfsdk:"premium_since"`
+	Avatar        types.String `tfsdk:"avatar"`
+	Nick          types.String `tfsdk:"nick"`
+	Roles         types.Set    `tfsdk:"roles"`
+	InServer      types.Bool   `tfsdk:"in_server"`
+}
+
+func dataSourceDiscordMember() datasource.DataSource {
+	return &memberDataSource{}
+}
+
+func (d *memberDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_member"
+}
 
-		Schema: map[string]*schema.Schema{
-			"server_id": {
-				Type:        schema.TypeString,
+func (d *memberDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches a member's information from a server.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
 				Required:    true,
 				Description: "The server ID to search for the user in.",
 			},
-			"user_id": {
-				ExactlyOneOf: []string{"user_id", "username"},
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The user ID to search for. Required if not searching by `username` / `discriminator`.",
-			},
-			"username": {
-				ExactlyOneOf: []string{"user_id", "username"},
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The username to search for.",
+			"user_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The user ID to search for. Required if not searching by `username` / `discriminator`.",
 			},
-			"discriminator": {
-				Type:        schema.TypeString,
+			"username": schema.StringAttribute{
 				Optional:    true,
-				Description: "The discriminator to search for. `username` is required when using this.",
-				Deprecated:  "Discriminator is being deprecated by Discord. Only use this if there are users who haven't migrated their username.",
+				Computed:    true,
+				Description: "The username to search for.",
+			},
+			"discriminator": schema.StringAttribute{
+				Optional:           true,
+				Computed:           true,
+				DeprecationMessage: "Discriminator is being deprecated by Discord. Only use this if there are users who haven't migrated their username.",
+				Description:        "The discriminator to search for. `username` is required when using this.",
 			},
-			"id": {
-				Type:        schema.TypeString,
+			"id": schema.StringAttribute{
 				Computed:    true,
 				Description: "The user's ID.",
 			},
-			"joined_at": {
-				Type:        schema.TypeString,
+			"joined_at": schema.StringAttribute{
 				Computed:    true,
 				Description: "The time at which the user joined.",
 			},
-			"premium_since": {
-				Type:        schema.TypeString,
+			"premium_since": schema.StringAttribute{
 				Computed:    true,
 				Description: "The time at which the user became premium.",
 			},
-			"avatar": {
-				Type:        schema.TypeString,
+			"avatar": schema.StringAttribute{
 				Computed:    true,
 				Description: "The avatar hash of the user.",
 			},
-			"nick": {
-				Type:        schema.TypeString,
+			"nick": schema.StringAttribute{
 				Computed:    true,
 				Description: "The current nickname of the user.",
 			},
-			"roles": {
-				Type:        schema.TypeSet,
-				Elem:        &schema.Schema{Type: schema.TypeString},
+			"roles": schema.SetAttribute{
+				ElementType: types.StringType,
 				Computed:    true,
-				Set:         schema.HashString,
 				Description: "IDs of the roles that the user has.",
 			},
-			"in_server": {
-				Type:        schema.TypeBool,
+			"in_server": schema.BoolAttribute{
 				Computed:    true,
 				Description: "Whether the user is in the server.",
 			},
@@ -79,73 +94,71 @@ func dataSourceDiscordMember() *schema.Resource {
 	}
 }
 
-func dataSourceMemberRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	var member *discordgo.Member
-	var memberErr error
-	client := m.(*Context).Session
-	serverId := d.Get("server_id").(string)
-
-	if v, ok := d.GetOk("user_id"); ok {
+func (d *memberDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("user_id"), path.MatchRoot("username")),
+	}
+}
 
-		member, memberErr = client.GuildMember(serverId, v.(string), discordgo.WithContext(ctx))
+func (d *memberDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data memberDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if v, ok := d.GetOk("username"); ok {
-		username := v.(string)
-		members, err := client.GuildMembersSearch(serverId, username, 1, discordgo.WithContext(ctx))
+	serverId := data.ServerId.ValueString()
+	var member *discordgo.Member
+	if !data.UserId.IsNull() {
+		userId := data.UserId.ValueString()
+		var err error
+		member, err = d.client.GuildMember(serverId, userId, discordgo.WithContext(ctx))
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to fetch member %s of %s", userId, serverId), err.Error())
+			return
+		}
+	} else {
+		username := data.Username.ValueString()
+		members, err := d.client.GuildMembersSearch(serverId, username, 1, discordgo.WithContext(ctx))
 		if err != nil {
-			return diag.Errorf("Failed to fetch members for %s: %s", serverId, err.Error())
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to fetch members for %s", serverId), err.Error())
+			return
 		}
 
-		discriminator := d.Get("discriminator").(string)
-		memberErr = fmt.Errorf("failed to find member by name#discriminator: %s#%s", username, discriminator)
+		discriminator := data.Discriminator.ValueString()
 		for _, m := range members {
 			if m.User.Username == username && m.User.Discriminator == discriminator {
 				member = m
-				memberErr = nil
 				break
 			}
 		}
-	}
-	if memberErr != nil {
-		return diag.FromErr(memberErr)
-	}
-	d.Set("in_server", memberErr == nil)
-	if memberErr != nil {
-		d.Set("joined_at", nil)
-		d.Set("premium_since", nil)
-		d.Set("roles", nil)
-		d.Set("username", nil)
-		d.Set("discriminator", nil)
-		d.Set("avatar", nil)
-		d.Set("nick", nil)
-		return diags
+		if member == nil {
+			resp.Diagnostics.AddError("Failed to find member", fmt.Sprintf("No member is named %s#%s", username, discriminator))
+			return
+		}
 	}
 
-	roles := make([]string, 0, len(member.Roles))
-	for _, r := range member.Roles {
-		roles = append(roles, r)
-	}
-	if member.PremiumSince == nil {
-		d.Set("premium_since", nil)
-	}
+	roles, diags := setOfStrings(ctx, member.Roles)
+	resp.Diagnostics.Append(diags...)
 
 	var discriminator string
-	if member.User.Discriminator == "0" {
+	if member.User.Discriminator != "0" {
 		// Use an empty string to indicate no discriminator
-		discriminator = ""
-	} else {
 		discriminator = member.User.Discriminator
 	}
 
-	d.SetId(member.User.ID)
-	d.Set("joined_at", member.JoinedAt.String())
-	d.Set("roles", roles)
-	d.Set("username", member.User.Username)
-	d.Set("discriminator", discriminator)
-	d.Set("avatar", member.User.Avatar)
-	d.Set("nick", member.Nick)
+	data.Id = types.StringValue(member.User.ID)
+	data.InServer = types.BoolValue(true)
+	data.JoinedAt = types.StringValue(member.JoinedAt.String())
+	data.PremiumSince = types.StringNull()
+	if member.PremiumSince != nil {
+		data.PremiumSince = types.StringValue(member.PremiumSince.String())
+	}
+	data.Roles = roles
+	data.Username = types.StringValue(member.User.Username)
+	data.Discriminator = types.StringValue(discriminator)
+	data.Avatar = types.StringValue(member.User.Avatar)
+	data.Nick = types.StringValue(member.Nick)
 
-	return diags
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -1,90 +1,117 @@
 package discord
 
 import (
+	"fmt"
+
 	"github.com/bwmarrin/discordgo"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"golang.org/x/net/context"
 )
 
-func dataSourceDiscordMembers() *schema.Resource {
-	return &schema.Resource{
-		ReadContext: dataSourceMembersRead,
-		Description: "Fetches all members in a server.",
+type membersDataSource struct {
+	frameworkDataSource
+}
+
+type membersDataSourceModel struct {
+	ServerId types.String      `tfsdk:"server_id"`
+	Members  []memberDataModel `tfsdk:"members"`
+	Id       types.String      `tfsdk:"id"`
+}
+
+type memberDataModel struct {
+	UserId        types.String `tfsdk:"user_id"`
+	Username      types.String `tfsdk:"username"`
+	Discriminator types.String `tfsdk:"discriminator"`
+	JoinedAt      types.String `tfsdk:"joined_at"`
+	PremiumSince  types.String `tfsdk:"premium_since"`
+	Avatar        types.String `tfsdk:"avatar"`
+	Nick          types.String `tfsdk:"nick"`
+	Roles         types.Set    `tfsdk:"roles"`
+}
+
+func dataSourceDiscordMembers() datasource.DataSource {
+	return &membersDataSource{}
+}
+
+func (d *membersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_members"
+}
 
-		Schema: map[string]*schema.Schema{
-			"server_id": {
-				Type:        schema.TypeString,
+func (d *membersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches all members in a server.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
 				Required:    true,
 				Description: "The server ID to search for the user in.",
 			},
-			"members": {
-				Type:        schema.TypeList,
+			"members": schema.ListNestedAttribute{
 				Computed:    true,
 				Description: "The members in the server.",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"user_id": {
-							Type:        schema.TypeString,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"user_id": schema.StringAttribute{
 							Computed:    true,
 							Description: "The user's ID.",
 						},
-						"username": {
-							Type:        schema.TypeString,
+						"username": schema.StringAttribute{
 							Computed:    true,
 							Description: "The user's username.",
 						},
-						"discriminator": {
-							Type:        schema.TypeString,
+						"discriminator": schema.StringAttribute{
 							Computed:    true,
 							Description: "The user's discriminator.",
 						},
-						"joined_at": {
-							Type:        schema.TypeString,
+						"joined_at": schema.StringAttribute{
 							Computed:    true,
 							Description: "The time at which the user joined.",
 						},
-						"premium_since": {
-							Type:        schema.TypeString,
+						"premium_since": schema.StringAttribute{
 							Computed:    true,
 							Description: "The time at which the user became premium.",
 						},
-						"avatar": {
-							Type:        schema.TypeString,
+						"avatar": schema.StringAttribute{
 							Computed:    true,
 							Description: "The avatar hash of the user.",
 						},
-						"nick": {
-							Type:        schema.TypeString,
+						"nick": schema.StringAttribute{
 							Computed:    true,
 							Description: "The current nickname of the user.",
 						},
-						"roles": {
-							Type:        schema.TypeSet,
-							Elem:        &schema.Schema{Type: schema.TypeString},
+						"roles": schema.SetAttribute{
+							ElementType: types.StringType,
 							Computed:    true,
-							Set:         schema.HashString,
 							Description: "IDs of the roles that the user has.",
 						},
 					},
 				},
 			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the server.",
+			},
 		},
 	}
 }
 
-func dataSourceMembersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	var members []*discordgo.Member
-	client := m.(*Context).Session
-	serverId := d.Get("server_id").(string)
+func (d *membersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data membersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
+	serverId := data.ServerId.ValueString()
+	var members []*discordgo.Member
 	after := ""
 	// Fetch all members, with pagination
 	for {
-		page, err := client.GuildMembers(serverId, after, 1000, discordgo.WithContext(ctx))
+		page, err := d.client.GuildMembers(serverId, after, 1000, discordgo.WithContext(ctx))
 		if err != nil {
-			return diag.Errorf("Failed to fetch members for %s: %s", serverId, err.Error())
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to fetch members for %s", serverId), err.Error())
+			return
 		}
 		members = append(members, page...)
 		if len(page) < 1000 {
@@ -93,34 +120,34 @@ func dataSourceMembersRead(ctx context.Context, d *schema.ResourceData, m interf
 		after = page[len(page)-1].User.ID
 	}
 
-	memberList := make([]interface{}, 0, len(members))
+	data.Id = types.StringValue(serverId)
+	data.Members = make([]memberDataModel, 0, len(members))
 	for _, member := range members {
-		roles := make([]string, 0, len(member.Roles))
-		for _, r := range member.Roles {
-			roles = append(roles, r)
-		}
+		roles, diags := setOfStrings(ctx, member.Roles)
+		resp.Diagnostics.Append(diags...)
 
 		var discriminator string
-		if member.User.Discriminator == "0" {
+		if member.User.Discriminator != "0" {
 			// Use an empty string to indicate no discriminator
-			discriminator = ""
-		} else {
 			discriminator = member.User.Discriminator
 		}
 
-		memberList = append(memberList, map[string]interface{}{
-			"user_id":       member.User.ID,
-			"username":      member.User.Username,
-			"discriminator": discriminator,
-			"joined_at":     member.JoinedAt.String(),
-			"avatar":        member.User.Avatar,
-			"nick":          member.Nick,
-			"roles":         roles,
+		premiumSince := types.StringNull()
+		if member.PremiumSince != nil {
+			premiumSince = types.StringValue(member.PremiumSince.String())
+		}
+
+		data.Members = append(data.Members, memberDataModel{
+			UserId:        types.StringValue(member.User.ID),
+			Username:      types.StringValue(member.User.Username),
+			Discriminator: types.StringValue(discriminator),
+			JoinedAt:      types.StringValue(member.JoinedAt.String()),
+			PremiumSince:  premiumSince,
+			Avatar:        types.StringValue(member.User.Avatar),
+			Nick:          types.StringValue(member.Nick),
+			Roles:         roles,
 		})
 	}
 
-	d.SetId(serverId)
-	d.Set("members", memberList)
-
-	return diags
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
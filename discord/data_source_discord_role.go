@@ -2,66 +2,84 @@ package discord
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/bwmarrin/discordgo"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-func dataSourceDiscordRole() *schema.Resource {
-	return &schema.Resource{
-		ReadContext: dataSourceDiscordRoleRead,
+type roleDataSource struct {
+	frameworkDataSource
+}
+
+type roleDataSourceModel struct {
+	ServerId    types.String `tfsdk:"server_id"`
+	RoleId      types.String `tfsdk:"role_id"`
+	Name        types.String `tfsdk:"name"`
+	Id          types.String `tfsdk:"id"`
+	Position    types.Int64  `tfsdk:"position"`
+	Color       types.Int64  `tfsdk:"color"`
+	Permissions types.Int64  `tfsdk:"permissions"`
+	Hoist       types.Bool   `tfsdk:"hoist"`
+	Mentionable types.Bool   `tfsdk:"mentionable"`
+	Managed     types.Bool   `tfsdk:"managed"`
+}
+
+func dataSourceDiscordRole() datasource.DataSource {
+	return &roleDataSource{}
+}
+
+func (d *roleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role"
+}
+
+func (d *roleDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
 		Description: "Fetches a role's information from a server.",
-		Schema: map[string]*schema.Schema{
-			"server_id": {
-				Type:        schema.TypeString,
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
 				Required:    true,
 				Description: "The server ID to search for the user in.",
 			},
-			"role_id": {
-				ExactlyOneOf: []string{"role_id", "name"},
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The user ID to search for. Either this or `name` is required.",
+			"role_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The user ID to search for. Either this or `name` is required.",
 			},
-			"name": {
-				ExactlyOneOf: []string{"role_id", "name"},
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The role name to search for. Either this or `role_id` is required.",
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The role name to search for. Either this or `role_id` is required.",
 			},
-			"id": {
-				Type:        schema.TypeString,
+			"id": schema.StringAttribute{
 				Computed:    true,
 				Description: "The ID of the role.",
 			},
-			"position": {
-				Type:        schema.TypeInt,
+			"position": schema.Int64Attribute{
 				Computed:    true,
 				Description: "Position of the role. This is reverse-indexed, with `@everyone` being `0`.",
 			},
-			"color": {
-				Type:        schema.TypeInt,
+			"color": schema.Int64Attribute{
 				Computed:    true,
 				Description: "The integer representation of the role's color with decimal color code.",
 			},
-			"permissions": {
-				Type:        schema.TypeInt,
+			"permissions": schema.Int64Attribute{
 				Computed:    true,
 				Description: "The permission bits of the role.",
 			},
-			"hoist": {
-				Type:        schema.TypeBool,
+			"hoist": schema.BoolAttribute{
 				Computed:    true,
 				Description: "Whether the role is hoisted.",
 			},
-			"mentionable": {
-				Type:        schema.TypeBool,
+			"mentionable": schema.BoolAttribute{
 				Computed:    true,
 				Description: "Whether the role is mentionable.",
 			},
-			"managed": {
-				Type:        schema.TypeBool,
+			"managed": schema.BoolAttribute{
 				Computed:    true,
 				Description: "Whether the role is managed.",
 			},
@@ -69,39 +87,49 @@ func dataSourceDiscordRole() *schema.Resource {
 	}
 }
 
-func dataSourceDiscordRoleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	var err error
-	var role *discordgo.Role
-	client := m.(*Context).Session
+func (d *roleDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("role_id"), path.MatchRoot("name")),
+	}
+}
+
+func (d *roleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data roleDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	serverId := d.Get("server_id").(string)
-	server, err := client.Guild(serverId, discordgo.WithContext(ctx))
+	serverId := data.ServerId.ValueString()
+	server, err := d.client.Guild(serverId, discordgo.WithContext(ctx))
 	if err != nil {
-		return diag.Errorf("Failed to fetch server %s: %s", serverId, err.Error())
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to fetch server %s", serverId), err.Error())
+		return
 	}
 
-	roleID := d.Get("role_id").(string)
-	roleName := d.Get("name").(string)
+	var role *discordgo.Role
+	roleId := data.RoleId.ValueString()
+	roleName := data.Name.ValueString()
 	for _, r := range server.Roles {
-		if r.ID == roleID || r.Name == roleName {
+		if r.ID == roleId || r.Name == roleName {
 			role = r
 			break
 		}
 	}
 	if role == nil {
-		return diag.Errorf("Failed to find role by ID %s or name: %s", roleID, roleName)
+		resp.Diagnostics.AddError("Failed to find role", fmt.Sprintf("No role has the ID %s or the name %s", roleId, roleName))
+		return
 	}
 
-	d.SetId(role.ID)
-	d.Set("role_id", role.ID)
-	d.Set("name", role.Name)
-	d.Set("position", role.Position)
-	d.Set("color", role.Color)
-	d.Set("hoist", role.Hoist)
-	d.Set("mentionable", role.Mentionable)
-	d.Set("permissions", int(role.Permissions))
-	d.Set("managed", role.Managed)
+	data.Id = types.StringValue(role.ID)
+	data.RoleId = types.StringValue(role.ID)
+	data.Name = types.StringValue(role.Name)
+	data.Position = types.Int64Value(int64(role.Position))
+	data.Color = types.Int64Value(int64(role.Color))
+	data.Hoist = types.BoolValue(role.Hoist)
+	data.Mentionable = types.BoolValue(role.Mentionable)
+	data.Permissions = types.Int64Value(role.Permissions)
+	data.Managed = types.BoolValue(role.Managed)
 
-	return diags
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -18,8 +18,8 @@ func TestAccDatasourceDiscordRole(t *testing.T) {
 
 	name := "data.discord_role.example"
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDatasourceDiscordRoleID(testServerID, testRoleID),
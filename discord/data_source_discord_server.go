@@ -2,76 +2,95 @@ package discord
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 
 	"github.com/bwmarrin/discordgo"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-func dataSourceDiscordServer() *schema.Resource {
-	return &schema.Resource{
-		ReadContext: dataSourceDiscordServerRead,
+type serverDataSource struct {
+	frameworkDataSource
+}
+
+type serverDataSourceModel struct {
+	ServerId                    types.String `tfsdk:"server_id"`
+	Name                        types.String `tfsdk:"name"`
+	Id                          types.String `tfsdk:"id"`
+	Region                      types.String `tfsdk:"region"`
+	DefaultMessageNotifications types.Int64  `tfsdk:"default_message_notifications"`
+	VerificationLevel           types.Int64  `tfsdk:"verification_level"`
+	ExplicitContentFilter       types.Int64  `tfsdk:"explicit_content_filter"`
+	AfkTimeout                  types.Int64  `tfsdk:"afk_timeout"`
+	IconHash                    types.String `tfsdk:"icon_hash"`
+	SplashHash                  types.String `tfsdk:"splash_hash"`
+	AfkChannelId                types.Int64  `tfsdk:"afk_channel_id"`
+	OwnerId                     types.String `tfsdk:"owner_id"`
+}
+
+func dataSourceDiscordServer() datasource.DataSource {
+	return &serverDataSource{}
+}
+
+func (d *serverDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server"
+}
+
+func (d *serverDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
 		Description: "Fetches a server's information.",
-		Schema: map[string]*schema.Schema{
-			"server_id": {
-				ExactlyOneOf: []string{"server_id", "name"},
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The server ID to search for.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The server ID to search for.",
 			},
-			"name": {
-				ExactlyOneOf: []string{"server_id", "name"},
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The server name to search for.",
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The server name to search for.",
 			},
-			"id": {
-				Type:        schema.TypeString,
+			"id": schema.StringAttribute{
 				Computed:    true,
 				Description: "The ID of the server.",
 			},
-			"region": {
-				Type:        schema.TypeString,
+			"region": schema.StringAttribute{
 				Computed:    true,
 				Description: "The region of the server.",
 			},
-			"default_message_notifications": {
-				Type:        schema.TypeInt,
+			"default_message_notifications": schema.Int64Attribute{
 				Computed:    true,
 				Description: "The default message notification level of the server.",
 			},
-			"verification_level": {
-				Type:        schema.TypeInt,
+			"verification_level": schema.Int64Attribute{
 				Computed:    true,
 				Description: "The required verification level of the server.",
 			},
-			"explicit_content_filter": {
-				Type:        schema.TypeInt,
+			"explicit_content_filter": schema.Int64Attribute{
 				Computed:    true,
 				Description: "The explicit content filter level of the server.",
 			},
-			"afk_timeout": {
-				Type:        schema.TypeInt,
+			"afk_timeout": schema.Int64Attribute{
 				Computed:    true,
 				Description: "The AFK timeout of the server.",
 			},
-			"icon_hash": {
-				Type:        schema.TypeString,
+			"icon_hash": schema.StringAttribute{
 				Computed:    true,
 				Description: "The hash of the server icon.",
 			},
-			"splash_hash": {
-				Type:        schema.TypeString,
+			"splash_hash": schema.StringAttribute{
 				Computed:    true,
 				Description: "The hash of the server splash.",
 			},
-			"afk_channel_id": {
-				Type:        schema.TypeInt,
+			"afk_channel_id": schema.Int64Attribute{
 				Computed:    true,
 				Description: "The AFK channel ID.",
 			},
-			"owner_id": {
-				Type:        schema.TypeString,
+			"owner_id": schema.StringAttribute{
 				Computed:    true,
 				Description: "The ID of the owner.",
 			},
@@ -79,57 +98,75 @@ func dataSourceDiscordServer() *schema.Resource {
 	}
 }
 
-func dataSourceDiscordServerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	var err error
-	var server *discordgo.Guild
-	client := m.(*Context).Session
+func (d *serverDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("server_id"), path.MatchRoot("name")),
+	}
+}
 
-	if v, ok := d.GetOk("server_id"); ok {
-		server, err = client.Guild(v.(string), discordgo.WithContext(ctx))
+func (d *serverDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data serverDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var server *discordgo.Guild
+	var err error
+	if !data.ServerId.IsNull() {
+		serverId := data.ServerId.ValueString()
+		server, err = d.client.Guild(serverId, discordgo.WithContext(ctx))
 		if err != nil {
-			return diag.Errorf("Failed to fetch server %s: %s", v.(string), err.Error())
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to fetch server %s", serverId), err.Error())
+			return
 		}
 	}
-	if v, ok := d.GetOk("name"); ok {
+	if !data.Name.IsNull() {
+		name := data.Name.ValueString()
 		// Discord API supports max 200 guilds per request
-		guilds, err := client.UserGuilds(200, "", "", false, discordgo.WithContext(ctx))
+		guilds, err := d.client.UserGuilds(200, "", "", false, discordgo.WithContext(ctx))
 		if err != nil {
-			return diag.Errorf("Failed to fetch server list: %s", err.Error())
+			resp.Diagnostics.AddError("Failed to fetch server list", err.Error())
+			return
 		}
 
 		for _, s := range guilds {
-			if s.Name == v.(string) {
-				server, err = client.Guild(s.ID, discordgo.WithContext(ctx))
+			if s.Name == name {
+				server, err = d.client.Guild(s.ID, discordgo.WithContext(ctx))
 				if err != nil {
-					return diag.Errorf("Failed to fetch server %s: %s", v.(string), err.Error())
+					resp.Diagnostics.AddError(fmt.Sprintf("Failed to fetch server %s", name), err.Error())
+					return
 				}
 				break
 			}
 		}
 
 		if server == nil {
-			return diag.Errorf("Failed to fetch server %s (nil response)", v.(string))
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to fetch server %s", name), "No server has this name")
+			return
 		}
 	}
 
-	d.SetId(server.ID)
-	d.Set("server_id", server.ID)
-	d.Set("name", server.Name)
-	d.Set("region", server.Region)
-	d.Set("afk_timeout", server.AfkTimeout)
-	d.Set("icon_hash", server.Icon)
-	d.Set("splash_hash", server.Splash)
-	d.Set("default_message_notifications", int(server.DefaultMessageNotifications))
-	d.Set("verification_level", int(server.VerificationLevel))
-	d.Set("explicit_content_filter", int(server.ExplicitContentFilter))
-
+	data.Id = types.StringValue(server.ID)
+	data.ServerId = types.StringValue(server.ID)
+	data.Name = types.StringValue(server.Name)
+	data.Region = types.StringValue(server.Region)
+	data.AfkTimeout = types.Int64Value(int64(server.AfkTimeout))
+	data.IconHash = types.StringValue(server.Icon)
+	data.SplashHash = types.StringValue(server.Splash)
+	data.DefaultMessageNotifications = types.Int64Value(int64(server.DefaultMessageNotifications))
+	data.VerificationLevel = types.Int64Value(int64(server.VerificationLevel))
+	data.ExplicitContentFilter = types.Int64Value(int64(server.ExplicitContentFilter))
+	data.AfkChannelId = types.Int64Null()
 	if server.AfkChannelID != "" {
-		d.Set("afk_channel_id", server.AfkChannelID)
-	}
-	if server.OwnerID != "" {
-		d.Set("owner_id", server.OwnerID)
+		afkChannelId, err := strconv.ParseInt(server.AfkChannelID, 10, 64)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to parse the AFK channel ID %s", server.AfkChannelID), err.Error())
+			return
+		}
+		data.AfkChannelId = types.Int64Value(afkChannelId)
 	}
+	data.OwnerId = stringValue(server.OwnerID, types.StringNull())
 
-	return diags
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -0,0 +1,132 @@
+package discord
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type stickerDataSource struct {
+	frameworkDataSource
+}
+
+type stickerDataSourceModel struct {
+	ServerId    types.String `tfsdk:"server_id"`
+	StickerId   types.String `tfsdk:"sticker_id"`
+	Name        types.String `tfsdk:"name"`
+	Id          types.String `tfsdk:"id"`
+	Description types.String `tfsdk:"description"`
+	Tags        types.String `tfsdk:"tags"`
+	FormatType  types.String `tfsdk:"format_type"`
+	Available   types.Bool   `tfsdk:"available"`
+}
+
+func dataSourceDiscordSticker() datasource.DataSource {
+	return &stickerDataSource{}
+}
+
+func (d *stickerDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sticker"
+}
+
+func (d *stickerDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches a custom sticker's information from a server.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The server ID to search for the sticker in.",
+			},
+			"sticker_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The sticker ID to search for. Either this or `name` is required.",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The sticker name to search for. Either this or `sticker_id` is required.",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the sticker.",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "Description of the sticker.",
+			},
+			"tags": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the unicode emoji related to the sticker.",
+			},
+			"format_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Format of the sticker, one of `png`, `apng`, `lottie` or `gif`.",
+			},
+			"available": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the sticker can be used.",
+			},
+		},
+	}
+}
+
+func (d *stickerDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("sticker_id"), path.MatchRoot("name")),
+	}
+}
+
+func (d *stickerDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data stickerDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverId := data.ServerId.ValueString()
+	endpoint := discordgo.EndpointGuildStickers(serverId)
+	body, err := d.client.RequestWithBucketID(http.MethodGet, endpoint, nil, endpoint, discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to fetch stickers for %s", serverId), err.Error())
+		return
+	}
+
+	var stickers []*discordgo.Sticker
+	if err := json.Unmarshal(body, &stickers); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to fetch stickers for %s", serverId), err.Error())
+		return
+	}
+
+	var sticker *discordgo.Sticker
+	stickerId := data.StickerId.ValueString()
+	stickerName := data.Name.ValueString()
+	for _, s := range stickers {
+		if s.ID == stickerId || s.Name == stickerName {
+			sticker = s
+			break
+		}
+	}
+	if sticker == nil {
+		resp.Diagnostics.AddError("Failed to find sticker", fmt.Sprintf("No sticker has the ID %s or the name %s", stickerId, stickerName))
+		return
+	}
+
+	data.Id = types.StringValue(sticker.ID)
+	data.StickerId = types.StringValue(sticker.ID)
+	data.Name = types.StringValue(sticker.Name)
+	data.Description = types.StringValue(sticker.Description)
+	data.Tags = types.StringValue(sticker.Tags)
+	data.FormatType = types.StringValue(getTextStickerFormat(sticker.FormatType))
+	data.Available = types.BoolValue(sticker.Available)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
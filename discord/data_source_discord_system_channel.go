@@ -2,29 +2,45 @@ package discord
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/bwmarrin/discordgo"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-func dataSourceDiscordSystemChannel() *schema.Resource {
-	return &schema.Resource{
-		ReadContext: dataSourceDiscordSystemChannelRead,
+type systemChannelDataSource struct {
+	frameworkDataSource
+}
+
+type systemChannelDataSourceModel struct {
+	ServerId        types.String `tfsdk:"server_id"`
+	SystemChannelId types.String `tfsdk:"system_channel_id"`
+	Id              types.String `tfsdk:"id"`
+}
+
+func dataSourceDiscordSystemChannel() datasource.DataSource {
+	return &systemChannelDataSource{}
+}
+
+func (d *systemChannelDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_system_channel"
+}
+
+func (d *systemChannelDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
 		Description: "Fetches a server's system channel.",
-		Schema: map[string]*schema.Schema{
-			"server_id": {
-				Type:        schema.TypeString,
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
 				Required:    true,
 				Description: "The server ID to search for.",
 			},
-			"system_channel_id": {
-				Type:        schema.TypeString,
+			"system_channel_id": schema.StringAttribute{
 				Computed:    true,
 				Description: "The ID of the server's system channel.",
 			},
-			"id": {
-				Type:        schema.TypeString,
+			"id": schema.StringAttribute{
 				Computed:    true,
 				Description: "The ID of the server.",
 			},
@@ -32,19 +48,22 @@ func dataSourceDiscordSystemChannel() *schema.Resource {
 	}
 }
 
-func dataSourceDiscordSystemChannelRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	var err error
-	var server *discordgo.Guild
-	client := m.(*Context).Session
-
-	serverId := d.Get("server_id").(string)
-	if server, err = client.Guild(serverId, discordgo.WithContext(ctx)); err != nil {
-		return diag.Errorf("Failed to fetch server %s: %s", serverId, err.Error())
-	} else {
-		d.SetId(serverId)
-		d.Set("system_channel_id", server.SystemChannelID)
+func (d *systemChannelDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data systemChannelDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-		return diags
+	serverId := data.ServerId.ValueString()
+	server, err := d.client.Guild(serverId, discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to fetch server %s", serverId), err.Error())
+		return
 	}
+
+	data.Id = types.StringValue(serverId)
+	data.SystemChannelId = types.StringValue(server.SystemChannelID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
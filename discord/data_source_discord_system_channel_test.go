@@ -16,8 +16,8 @@ func TestAccDatasourceDiscordSystemChannel(t *testing.T) {
 
 	name := "data.discord_system_channel.example"
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDatasourceDiscordSystemChannel(testServerID),
@@ -0,0 +1,78 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type vanityURLDataSource struct {
+	frameworkDataSource
+}
+
+type vanityURLDataSourceModel struct {
+	ServerId types.String `tfsdk:"server_id"`
+	Code     types.String `tfsdk:"code"`
+	URL      types.String `tfsdk:"url"`
+	Id       types.String `tfsdk:"id"`
+}
+
+func dataSourceDiscordVanityURL() datasource.DataSource {
+	return &vanityURLDataSource{}
+}
+
+func (d *vanityURLDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vanity_url"
+}
+
+func (d *vanityURLDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches a server's vanity invite code.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The server ID to search for.",
+			},
+			"code": schema.StringAttribute{
+				Computed:    true,
+				Description: "The vanity invite code, empty if the server has none.",
+			},
+			"url": schema.StringAttribute{
+				Computed:    true,
+				Description: "The vanity invite URL, empty if the server has none.",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the server.",
+			},
+		},
+	}
+}
+
+func (d *vanityURLDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data vanityURLDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverId := data.ServerId.ValueString()
+	server, err := d.client.Guild(serverId, discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to fetch server %s", serverId), err.Error())
+		return
+	}
+
+	data.Id = types.StringValue(serverId)
+	data.Code = types.StringValue(server.VanityURLCode)
+	data.URL = types.StringValue("")
+	if server.VanityURLCode != "" {
+		data.URL = types.StringValue(vanityURLBase + server.VanityURLCode)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,38 @@
+package discord
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatasourceDiscordVanityURL(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	if testServerID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID envvar must be set for acceptance tests")
+	}
+
+	name := "data.discord_vanity_url.example"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasourceDiscordVanityURL(testServerID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "server_id", testServerID),
+					resource.TestCheckResourceAttr(name, "id", testServerID),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatasourceDiscordVanityURL(serverId string) string {
+	return fmt.Sprintf(`
+	data "discord_vanity_url" "example" {
+	  server_id = "%[1]s"
+	}`, serverId)
+}
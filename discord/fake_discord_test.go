@@ -0,0 +1,2006 @@
+package discord
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	fakeDiscordToken       = "fake-discord-token"
+	fakeDiscordBearerToken = "fake-discord-bearer-token"
+	fakeDiscordAPIPrefix   = "/api/v9/"
+)
+
+// fakeDiscord is an in-memory stand-in for the Discord REST API. It keeps just
+// enough state for the provider's CRUD calls to round-trip, which lets the
+// acceptance tests run without a bot token or network access.
+type fakeDiscord struct {
+	*httptest.Server
+
+	mu     sync.Mutex
+	lastId uint64
+	routes []fakeRoute
+
+	users    map[string]fakeObject
+	guilds   map[string]fakeObject
+	channels map[string]fakeObject
+	roles    map[string][]fakeObject
+	members  map[string][]fakeObject
+	messages map[string]fakeObject
+	webhooks map[string]fakeObject
+	invites  map[string]fakeObject
+	stages   map[string]fakeObject
+	events   map[string]fakeObject
+	stickers map[string][]fakeObject
+	bans     map[string][]fakeObject
+	commands map[string]fakeObject
+	// commandPermissions are keyed by server ID and command ID.
+	commandPermissions map[string]fakeObject
+	automod            map[string]fakeObject
+	emojis             map[string][]fakeObject
+	// welcomeScreens and onboardings are keyed by server ID.
+	welcomeScreens map[string]fakeObject
+	onboardings    map[string]fakeObject
+
+	// The objects seeded by newFakeDiscord, mirroring the fixtures a real test
+	// server is expected to have.
+	botId     string
+	userId    string
+	banUserId string
+	serverId  string
+	channelId string
+	roleId    string
+}
+
+// fakeObject is a decoded JSON object. Objects are kept as maps rather than
+// discordgo structs so that PATCH requests can be applied key by key, including
+// keys discordgo has no field for.
+type fakeObject map[string]interface{}
+
+type fakeRequest struct {
+	params         []string
+	query          url.Values
+	contentType    string
+	auditLogReason string
+	bearer         bool
+	body           []byte
+}
+
+type fakeRoute struct {
+	method  string
+	pattern []string
+	handler func(r *fakeRequest) (int, interface{})
+}
+
+func newFakeDiscord() *fakeDiscord {
+	f := &fakeDiscord{
+		lastId:   100000000000000000,
+		users:    map[string]fakeObject{},
+		guilds:   map[string]fakeObject{},
+		channels: map[string]fakeObject{},
+		roles:    map[string][]fakeObject{},
+		members:  map[string][]fakeObject{},
+		messages: map[string]fakeObject{},
+		webhooks: map[string]fakeObject{},
+		invites:  map[string]fakeObject{},
+		stages:   map[string]fakeObject{},
+		events:   map[string]fakeObject{},
+		automod:  map[string]fakeObject{},
+		emojis:   map[string][]fakeObject{},
+		stickers: map[string][]fakeObject{},
+		bans:     map[string][]fakeObject{},
+		commands: map[string]fakeObject{},
+
+		commandPermissions: map[string]fakeObject{},
+		welcomeScreens:     map[string]fakeObject{},
+		onboardings:        map[string]fakeObject{},
+	}
+	f.routes = f.buildRoutes()
+	f.seed()
+	f.Server = httptest.NewServer(f)
+
+	return f
+}
+
+// setTestEnv exports the seeded fixtures through the same environment
+// variables the acceptance tests read when running against a real server.
+func (f *fakeDiscord) setTestEnv() {
+	os.Setenv("DISCORD_TOKEN", fakeDiscordToken)
+	os.Setenv("DISCORD_TEST_SERVER_ID", f.serverId)
+	os.Setenv("DISCORD_TEST_CHANNEL_ID", f.channelId)
+	os.Setenv("DISCORD_TEST_ROLE_ID", f.roleId)
+	os.Setenv("DISCORD_TEST_ROLE_NAME", f.roles[f.serverId][1]["name"].(string))
+	os.Setenv("DISCORD_TEST_USER_ID", f.userId)
+	os.Setenv("DISCORD_TEST_USERNAME", f.users[f.userId]["username"].(string))
+	os.Setenv("DISCORD_TEST_BAN_USER_ID", f.banUserId)
+	os.Setenv("DISCORD_TEST_BEARER_TOKEN", fakeDiscordBearerToken)
+}
+
+// transport returns a RoundTripper that sends every request to the fake, so
+// that remote images referenced by `*_url` arguments are served locally too.
+func (f *fakeDiscord) transport(next http.RoundTripper) http.RoundTripper {
+	target, _ := url.Parse(f.URL)
+
+	return fakeTransport{target: target, next: next}
+}
+
+type fakeTransport struct {
+	target *url.URL
+	next   http.RoundTripper
+}
+
+func (t fakeTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	r := req.Clone(req.Context())
+	r.URL.Scheme = t.target.Scheme
+	r.URL.Host = t.target.Host
+	r.Host = ""
+
+	return t.next.RoundTrip(r)
+}
+
+func (f *fakeDiscord) seed() {
+	bot := f.addUser("terraform-bot", "")
+	bot["bot"] = true
+	f.botId = bot["id"].(string)
+	f.userId = f.addUser("terraform-tester", "a_0123456789abcdef")["id"].(string)
+	// A user outside the test server that can be banned without affecting
+	// the tests that rely on the tester's membership.
+	f.banUserId = f.addUser("terraform-spammer", "")["id"].(string)
+
+	guild := f.addGuild("Discord Terraform Test Server")
+	guild["verification_level"] = 1
+	guild["explicit_content_filter"] = 2
+	guild["default_message_notifications"] = 1
+	f.serverId = guild["id"].(string)
+
+	role := f.addRole(f.serverId, fakeObject{"name": "Tester"})
+	f.roleId = role["id"].(string)
+
+	channel := f.addChannel(f.serverId, fakeObject{"name": "general", "position": 0})
+	f.channelId = channel["id"].(string)
+	guild["system_channel_id"] = f.channelId
+
+	f.addMember(f.serverId, f.userId)["roles"] = []interface{}{f.roleId}
+}
+
+func (f *fakeDiscord) buildRoutes() []fakeRoute {
+	return []fakeRoute{
+		{"GET", []string{"users", "@me"}, f.getCurrentUser},
+		{"GET", []string{"users", "@me", "guilds"}, f.getCurrentUserGuilds},
+		{"GET", []string{"users", "*"}, f.getUser},
+
+		{"POST", []string{"guilds"}, f.createGuild},
+		{"GET", []string{"guilds", "*"}, f.getGuild},
+		{"PATCH", []string{"guilds", "*"}, f.editGuild},
+		{"DELETE", []string{"guilds", "*"}, f.deleteGuild},
+		{"POST", []string{"guilds", "*", "mfa"}, f.editGuildMfa},
+		{"GET", []string{"guilds", "*", "welcome-screen"}, f.getWelcomeScreen},
+		{"PATCH", []string{"guilds", "*", "welcome-screen"}, f.editWelcomeScreen},
+		{"PATCH", []string{"guilds", "*", "widget"}, f.editGuildWidget},
+		{"PATCH", []string{"guilds", "*", "vanity-url"}, f.editGuildVanityURL},
+		{"GET", []string{"guilds", "*", "onboarding"}, f.getOnboarding},
+		{"PUT", []string{"guilds", "*", "onboarding"}, f.editOnboarding},
+
+		{"GET", []string{"guilds", "*", "channels"}, f.getGuildChannels},
+		{"POST", []string{"guilds", "*", "channels"}, f.createChannel},
+		{"PATCH", []string{"guilds", "*", "channels"}, f.reorderChannels},
+
+		{"GET", []string{"guilds", "*", "roles"}, f.getRoles},
+		{"POST", []string{"guilds", "*", "roles"}, f.createRole},
+		{"PATCH", []string{"guilds", "*", "roles"}, f.reorderRoles},
+		{"PATCH", []string{"guilds", "*", "roles", "*"}, f.editRole},
+		{"DELETE", []string{"guilds", "*", "roles", "*"}, f.deleteRole},
+
+		{"GET", []string{"guilds", "*", "members"}, f.getMembers},
+		{"GET", []string{"guilds", "*", "members", "search"}, f.searchMembers},
+		{"GET", []string{"guilds", "*", "members", "*"}, f.getMember},
+		{"GET", []string{"guilds", "*", "bans"}, f.getBans},
+		{"GET", []string{"guilds", "*", "bans", "*"}, f.getBan},
+		{"PUT", []string{"guilds", "*", "bans", "*"}, f.createBan},
+		{"DELETE", []string{"guilds", "*", "bans", "*"}, f.deleteBan},
+		{"PATCH", []string{"guilds", "*", "members", "*"}, f.editMember},
+
+		{"GET", []string{"channels", "*"}, f.getChannel},
+		{"PATCH", []string{"channels", "*"}, f.editChannel},
+		{"DELETE", []string{"channels", "*"}, f.deleteChannel},
+		{"POST", []string{"channels", "*", "threads"}, f.createThread},
+		{"PUT", []string{"channels", "*", "permissions", "*"}, f.setPermission},
+		{"DELETE", []string{"channels", "*", "permissions", "*"}, f.deletePermission},
+
+		{"POST", []string{"channels", "*", "messages"}, f.createMessage},
+		{"GET", []string{"channels", "*", "messages", "*"}, f.getMessage},
+		{"PATCH", []string{"channels", "*", "messages", "*"}, f.editMessage},
+		{"DELETE", []string{"channels", "*", "messages", "*"}, f.deleteMessage},
+		{"PUT", []string{"channels", "*", "pins", "*"}, f.pinMessage},
+		{"DELETE", []string{"channels", "*", "pins", "*"}, f.unpinMessage},
+
+		{"GET", []string{"channels", "*", "webhooks"}, f.getChannelWebhooks},
+		{"POST", []string{"channels", "*", "webhooks"}, f.createWebhook},
+		{"GET", []string{"webhooks", "*"}, f.getWebhook},
+		{"PATCH", []string{"webhooks", "*"}, f.editWebhook},
+		{"DELETE", []string{"webhooks", "*"}, f.deleteWebhook},
+
+		{"GET", []string{"channels", "*", "invites"}, f.getChannelInvites},
+		{"POST", []string{"channels", "*", "invites"}, f.createInvite},
+		{"GET", []string{"invites", "*"}, f.getInvite},
+		{"DELETE", []string{"invites", "*"}, f.deleteInvite},
+
+		{"GET", []string{"guilds", "*", "scheduled-events"}, f.getScheduledEvents},
+		{"POST", []string{"guilds", "*", "scheduled-events"}, f.createScheduledEvent},
+		{"GET", []string{"guilds", "*", "scheduled-events", "*"}, f.getScheduledEvent},
+		{"PATCH", []string{"guilds", "*", "scheduled-events", "*"}, f.editScheduledEvent},
+		{"DELETE", []string{"guilds", "*", "scheduled-events", "*"}, f.deleteScheduledEvent},
+
+		{"GET", []string{"guilds", "*", "emojis"}, f.getEmojis},
+		{"POST", []string{"guilds", "*", "emojis"}, f.createEmoji},
+		{"GET", []string{"guilds", "*", "emojis", "*"}, f.getEmoji},
+		{"PATCH", []string{"guilds", "*", "emojis", "*"}, f.editEmoji},
+		{"DELETE", []string{"guilds", "*", "emojis", "*"}, f.deleteEmoji},
+
+		{"GET", []string{"guilds", "*", "stickers"}, f.getStickers},
+		{"POST", []string{"guilds", "*", "stickers"}, f.createSticker},
+		{"GET", []string{"guilds", "*", "stickers", "*"}, f.getSticker},
+		{"PATCH", []string{"guilds", "*", "stickers", "*"}, f.editSticker},
+		{"DELETE", []string{"guilds", "*", "stickers", "*"}, f.deleteSticker},
+
+		{"GET", []string{"guilds", "*", "auto-moderation", "rules"}, f.getAutomodRules},
+		{"POST", []string{"guilds", "*", "auto-moderation", "rules"}, f.createAutomodRule},
+		{"GET", []string{"guilds", "*", "auto-moderation", "rules", "*"}, f.getAutomodRule},
+		{"PATCH", []string{"guilds", "*", "auto-moderation", "rules", "*"}, f.editAutomodRule},
+		{"DELETE", []string{"guilds", "*", "auto-moderation", "rules", "*"}, f.deleteAutomodRule},
+
+		{"GET", []string{"oauth2", "applications", "@me"}, f.getCurrentApplication},
+		{"GET", []string{"applications", "*", "commands"}, f.getCommands},
+		{"POST", []string{"applications", "*", "commands"}, f.createCommand},
+		{"GET", []string{"applications", "*", "commands", "*"}, f.getCommand},
+		{"PATCH", []string{"applications", "*", "commands", "*"}, f.editCommand},
+		{"DELETE", []string{"applications", "*", "commands", "*"}, f.deleteCommand},
+		{"GET", []string{"applications", "*", "guilds", "*", "commands"}, f.getCommands},
+		{"POST", []string{"applications", "*", "guilds", "*", "commands"}, f.createCommand},
+		{"GET", []string{"applications", "*", "guilds", "*", "commands", "*"}, f.getCommand},
+		{"PATCH", []string{"applications", "*", "guilds", "*", "commands", "*"}, f.editCommand},
+		{"DELETE", []string{"applications", "*", "guilds", "*", "commands", "*"}, f.deleteCommand},
+		{"GET", []string{"applications", "*", "guilds", "*", "commands", "*", "permissions"}, f.getCommandPermissions},
+		{"PUT", []string{"applications", "*", "guilds", "*", "commands", "*", "permissions"}, f.editCommandPermissions},
+
+		{"POST", []string{"stage-instances"}, f.createStageInstance},
+		{"GET", []string{"stage-instances", "*"}, f.getStageInstance},
+		{"PATCH", []string{"stage-instances", "*"}, f.editStageInstance},
+		{"DELETE", []string{"stage-instances", "*"}, f.deleteStageInstance},
+	}
+}
+
+func (f *fakeDiscord) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if !strings.HasPrefix(req.URL.Path, fakeDiscordAPIPrefix) {
+		// Anything outside of the API is a remote image being downloaded.
+		w.Header().Set("Content-Type", "image/png")
+		w.Write(fakeImage)
+		return
+	}
+
+	auth := req.Header.Get("Authorization")
+	if auth != "Bot "+fakeDiscordToken && auth != "Bearer "+fakeDiscordBearerToken {
+		status, res := fakeError(http.StatusUnauthorized, 0, "401: Unauthorized")
+		writeFakeResponse(w, status, res)
+		return
+	}
+
+	body, _ := io.ReadAll(req.Body)
+	reason, _ := url.PathUnescape(req.Header.Get("X-Audit-Log-Reason"))
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(req.URL.Path, fakeDiscordAPIPrefix), "/"), "/")
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, route := range f.routes {
+		if params, ok := route.match(req.Method, parts); ok {
+			status, res := route.handler(&fakeRequest{params: params, query: req.URL.Query(), contentType: req.Header.Get("Content-Type"), auditLogReason: reason, bearer: strings.HasPrefix(auth, "Bearer "), body: body})
+			writeFakeResponse(w, status, res)
+			return
+		}
+	}
+
+	status, res := fakeError(http.StatusNotFound, 0, "404: Not Found")
+	writeFakeResponse(w, status, res)
+}
+
+func (r fakeRoute) match(method string, parts []string) ([]string, bool) {
+	if r.method != method || len(r.pattern) != len(parts) {
+		return nil, false
+	}
+
+	params := make([]string, 0, len(parts))
+	for i, p := range r.pattern {
+		switch {
+		case p == "*":
+			params = append(params, parts[i])
+		case p != parts[i]:
+			return nil, false
+		}
+	}
+
+	return params, true
+}
+
+func writeFakeResponse(w http.ResponseWriter, status int, v interface{}) {
+	if status == http.StatusNoContent || v == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func fakeError(status int, code int, message string) (int, interface{}) {
+	return status, fakeObject{"code": code, "message": message}
+}
+
+// fakeImage is served for every remote image. imgbase64 ignores responses of
+// 512 bytes or less, so it is padded past that.
+var fakeImage = append([]byte("\x89PNG\r\n\x1a\n"), make([]byte, 1024)...)
+
+func (r *fakeRequest) object() fakeObject {
+	obj := fakeObject{}
+	r.decode(&obj)
+
+	return obj
+}
+
+// form decodes a multipart/form-data body. Fields are returned as strings and
+// uploaded files by their part name, as the content type they were sent with.
+func (r *fakeRequest) form() (fakeObject, map[string]string) {
+	fields, files := fakeObject{}, map[string]string{}
+	_, params, err := mime.ParseMediaType(r.contentType)
+	if err != nil {
+		return fields, files
+	}
+
+	reader := multipart.NewReader(bytes.NewReader(r.body), params["boundary"])
+	for {
+		part, err := reader.NextPart()
+		if err != nil {
+			break
+		}
+		if part.FileName() != "" {
+			files[part.FormName()] = part.Header.Get("Content-Type")
+		} else {
+			value, _ := io.ReadAll(part)
+			fields[part.FormName()] = string(value)
+		}
+	}
+
+	return fields, files
+}
+
+func (r *fakeRequest) list() []fakeObject {
+	var list []fakeObject
+	r.decode(&list)
+
+	return list
+}
+
+func (r *fakeRequest) decode(v interface{}) {
+	if len(r.body) == 0 {
+		return
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(r.body))
+	dec.UseNumber()
+	dec.Decode(v)
+}
+
+func (f *fakeDiscord) newId() string {
+	f.lastId++
+
+	return strconv.FormatUint(f.lastId, 10)
+}
+
+func fakeNow() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
+
+func fakeInt(v interface{}) int {
+	switch n := v.(type) {
+	case int:
+		return n
+	case json.Number:
+		i, _ := n.Int64()
+		return int(i)
+	case string:
+		i, _ := strconv.Atoi(n)
+		return i
+	}
+
+	return 0
+}
+
+// fakeImageKeys are the keys that take a data URI in requests and come back as
+// an image hash.
+var fakeImageKeys = []string{"icon", "splash", "discovery_splash", "banner", "avatar", "image"}
+
+// merge copies src over dst the way Discord applies a PATCH: keys that are not
+// in src are left alone, empty IDs clear the field, and images are hashed.
+func (obj fakeObject) merge(src fakeObject) fakeObject {
+	for k, v := range src {
+		switch {
+		case contains(fakeImageKeys, k):
+			if s, ok := v.(string); ok && s != "" {
+				v = fmt.Sprintf("%08x", crc32.ChecksumIEEE([]byte(s)))
+			} else {
+				v = nil
+			}
+		case strings.HasSuffix(k, "_id") && v == "":
+			v = nil
+		}
+		obj[k] = v
+	}
+
+	return obj
+}
+
+func (obj fakeObject) copy() fakeObject {
+	res := make(fakeObject, len(obj))
+	for k, v := range obj {
+		res[k] = v
+	}
+
+	return res
+}
+
+func (obj fakeObject) str(key string) string {
+	s, _ := obj[key].(string)
+
+	return s
+}
+
+func sortFakeObjects(list []fakeObject) {
+	sort.SliceStable(list, func(i, j int) bool {
+		pi, pj := fakeInt(list[i]["position"]), fakeInt(list[j]["position"])
+		if pi != pj {
+			return pi < pj
+		}
+
+		return len(list[i].str("id")) < len(list[j].str("id")) || list[i].str("id") < list[j].str("id")
+	})
+}
+
+// Users
+
+func (f *fakeDiscord) addUser(username string, avatar string) fakeObject {
+	user := fakeObject{
+		"id":            f.newId(),
+		"username":      username,
+		"discriminator": "0",
+		"global_name":   nil,
+		"avatar":        avatar,
+		"bot":           false,
+	}
+	f.users[user.str("id")] = user
+
+	return user
+}
+
+func (f *fakeDiscord) getCurrentUser(r *fakeRequest) (int, interface{}) {
+	return http.StatusOK, f.users[f.botId]
+}
+
+func (f *fakeDiscord) getCurrentUserGuilds(r *fakeRequest) (int, interface{}) {
+	guilds := make([]fakeObject, 0, len(f.guilds))
+	for _, g := range f.guilds {
+		guilds = append(guilds, fakeObject{
+			"id":          g["id"],
+			"name":        g["name"],
+			"icon":        g["icon"],
+			"owner":       g["owner_id"] == f.botId,
+			"permissions": "8",
+			"features":    g["features"],
+		})
+	}
+	sort.Slice(guilds, func(i, j int) bool { return guilds[i].str("id") < guilds[j].str("id") })
+
+	return http.StatusOK, guilds
+}
+
+func (f *fakeDiscord) getUser(r *fakeRequest) (int, interface{}) {
+	if user, ok := f.users[r.params[0]]; ok {
+		return http.StatusOK, user
+	}
+
+	return fakeError(http.StatusNotFound, 10013, "Unknown User")
+}
+
+// Guilds
+
+func (f *fakeDiscord) addGuild(name string) fakeObject {
+	guild := fakeObject{
+		"id":                            f.newId(),
+		"name":                          name,
+		"icon":                          nil,
+		"splash":                        nil,
+		"discovery_splash":              nil,
+		"banner":                        nil,
+		"description":                   nil,
+		"owner_id":                      f.botId,
+		"region":                        "us-west",
+		"afk_channel_id":                nil,
+		"afk_timeout":                   300,
+		"verification_level":            0,
+		"default_message_notifications": 0,
+		"explicit_content_filter":       0,
+		"mfa_level":                     0,
+		"features":                      []interface{}{},
+		"system_channel_id":             nil,
+		"system_channel_flags":          0,
+		"rules_channel_id":              nil,
+		"public_updates_channel_id":     nil,
+		"safety_alerts_channel_id":      nil,
+		"preferred_locale":              "en-US",
+		"premium_progress_bar_enabled":  false,
+		"widget_enabled":                false,
+		"widget_channel_id":             nil,
+		"vanity_url_code":               nil,
+	}
+	id := guild.str("id")
+	f.guilds[id] = guild
+	// The @everyone role shares its ID with the guild.
+	f.roles[id] = []fakeObject{f.newRole(id, fakeObject{"name": "@everyone", "position": 0, "permissions": "1071698660929"})}
+	f.addMember(id, f.botId)
+
+	return guild
+}
+
+func (f *fakeDiscord) guildResponse(guild fakeObject) fakeObject {
+	res := guild.copy()
+	res["roles"] = f.sortedRoles(guild.str("id"))
+	res["emojis"] = f.guildEmojis(guild.str("id"))
+	res["stickers"] = f.guildStickers(guild.str("id"))
+
+	return res
+}
+
+func (f *fakeDiscord) createGuild(r *fakeRequest) (int, interface{}) {
+	body := r.object()
+	guild := f.addGuild(body.str("name"))
+	delete(body, "name")
+	guild.merge(body)
+
+	return http.StatusCreated, f.guildResponse(guild)
+}
+
+func (f *fakeDiscord) getGuild(r *fakeRequest) (int, interface{}) {
+	if guild, ok := f.guilds[r.params[0]]; ok {
+		return http.StatusOK, f.guildResponse(guild)
+	}
+
+	return fakeError(http.StatusNotFound, 10004, "Unknown Guild")
+}
+
+func (f *fakeDiscord) editGuild(r *fakeRequest) (int, interface{}) {
+	guild, ok := f.guilds[r.params[0]]
+	if !ok {
+		return fakeError(http.StatusNotFound, 10004, "Unknown Guild")
+	}
+	guild.merge(r.object())
+
+	return http.StatusOK, f.guildResponse(guild)
+}
+
+func (f *fakeDiscord) editGuildMfa(r *fakeRequest) (int, interface{}) {
+	guild, ok := f.guilds[r.params[0]]
+	if !ok {
+		return fakeError(http.StatusNotFound, 10004, "Unknown Guild")
+	}
+	guild["mfa_level"] = r.object()["level"]
+
+	return http.StatusOK, fakeObject{"level": guild["mfa_level"]}
+}
+
+func (f *fakeDiscord) editGuildWidget(r *fakeRequest) (int, interface{}) {
+	guild, ok := f.guilds[r.params[0]]
+	if !ok {
+		return fakeError(http.StatusNotFound, 10004, "Unknown Guild")
+	}
+	body := r.object()
+	if enabled, ok := body["enabled"]; ok {
+		guild["widget_enabled"] = enabled
+	}
+	if channelId, ok := body["channel_id"]; ok {
+		guild["widget_channel_id"] = channelId
+	}
+
+	return http.StatusOK, fakeObject{"enabled": guild["widget_enabled"], "channel_id": guild["widget_channel_id"]}
+}
+
+func (f *fakeDiscord) editGuildVanityURL(r *fakeRequest) (int, interface{}) {
+	guild, ok := f.guilds[r.params[0]]
+	if !ok {
+		return fakeError(http.StatusNotFound, 10004, "Unknown Guild")
+	}
+	guild["vanity_url_code"] = r.object()["code"]
+
+	return http.StatusOK, fakeObject{"code": guild["vanity_url_code"], "uses": 0}
+}
+
+// Discord only reports whether the welcome screen is enabled through the
+// WELCOME_SCREEN_ENABLED feature of the guild.
+func (f *fakeDiscord) welcomeScreen(guildId string) fakeObject {
+	if screen, ok := f.welcomeScreens[guildId]; ok {
+		return screen
+	}
+
+	return fakeObject{"description": nil, "welcome_channels": []interface{}{}}
+}
+
+func (f *fakeDiscord) getWelcomeScreen(r *fakeRequest) (int, interface{}) {
+	if _, ok := f.guilds[r.params[0]]; !ok {
+		return fakeError(http.StatusNotFound, 10004, "Unknown Guild")
+	}
+
+	return http.StatusOK, f.welcomeScreen(r.params[0])
+}
+
+func (f *fakeDiscord) editWelcomeScreen(r *fakeRequest) (int, interface{}) {
+	guild, ok := f.guilds[r.params[0]]
+	if !ok {
+		return fakeError(http.StatusNotFound, 10004, "Unknown Guild")
+	}
+
+	body := r.object()
+	if enabled, ok := body["enabled"].(bool); ok {
+		features := make([]interface{}, 0)
+		for _, feature := range guild["features"].([]interface{}) {
+			if feature != "WELCOME_SCREEN_ENABLED" {
+				features = append(features, feature)
+			}
+		}
+		if enabled {
+			features = append(features, "WELCOME_SCREEN_ENABLED")
+		}
+		guild["features"] = features
+		delete(body, "enabled")
+	}
+	screen := f.welcomeScreen(r.params[0])
+	screen.merge(body)
+	f.welcomeScreens[r.params[0]] = screen
+
+	return http.StatusOK, screen
+}
+
+func (f *fakeDiscord) onboarding(guildId string) fakeObject {
+	if onboarding, ok := f.onboardings[guildId]; ok {
+		return onboarding
+	}
+
+	return fakeObject{"guild_id": guildId, "prompts": []interface{}{}, "default_channel_ids": []interface{}{}, "enabled": false, "mode": 0}
+}
+
+func (f *fakeDiscord) getOnboarding(r *fakeRequest) (int, interface{}) {
+	if _, ok := f.guilds[r.params[0]]; !ok {
+		return fakeError(http.StatusNotFound, 10004, "Unknown Guild")
+	}
+
+	return http.StatusOK, f.onboarding(r.params[0])
+}
+
+// editOnboarding replaces the onboarding like Discord does: options without an
+// ID get one, and their emoji is sent back as an object.
+func (f *fakeDiscord) editOnboarding(r *fakeRequest) (int, interface{}) {
+	if _, ok := f.guilds[r.params[0]]; !ok {
+		return fakeError(http.StatusNotFound, 10004, "Unknown Guild")
+	}
+
+	body := r.object()
+	prompts, _ := body["prompts"].([]interface{})
+	for _, p := range prompts {
+		options, _ := p.(map[string]interface{})["options"].([]interface{})
+		for _, o := range options {
+			option := o.(map[string]interface{})
+			if option["id"] == nil {
+				option["id"] = f.newId()
+			}
+			if option["emoji_id"] != nil || option["emoji_name"] != nil {
+				emoji := fakeObject{"id": option["emoji_id"], "name": option["emoji_name"], "animated": false}
+				// Custom emojis come back with their name too.
+				if id, ok := option["emoji_id"].(string); ok {
+					if custom := f.findEmoji(r.params[0], id); custom != nil {
+						emoji["name"] = custom["name"]
+					}
+				}
+				option["emoji"] = emoji
+			}
+			delete(option, "emoji_id")
+			delete(option, "emoji_name")
+		}
+	}
+
+	onboarding := f.onboarding(r.params[0]).copy()
+	for k, v := range body {
+		onboarding[k] = v
+	}
+	f.onboardings[r.params[0]] = onboarding
+
+	return http.StatusOK, onboarding
+}
+
+func (f *fakeDiscord) deleteGuild(r *fakeRequest) (int, interface{}) {
+	id := r.params[0]
+	if _, ok := f.guilds[id]; !ok {
+		return fakeError(http.StatusNotFound, 10004, "Unknown Guild")
+	}
+
+	delete(f.guilds, id)
+	delete(f.roles, id)
+	delete(f.welcomeScreens, id)
+	delete(f.onboardings, id)
+	delete(f.members, id)
+	for channelId, channel := range f.channels {
+		if channel.str("guild_id") == id {
+			delete(f.channels, channelId)
+		}
+	}
+
+	return http.StatusNoContent, nil
+}
+
+// Channels
+
+func (f *fakeDiscord) addChannel(guildId string, body fakeObject) fakeObject {
+	channel := fakeObject{
+		"id":                    f.newId(),
+		"guild_id":              guildId,
+		"type":                  0,
+		"name":                  "",
+		"topic":                 nil,
+		"position":              len(f.guildChannels(guildId)),
+		"nsfw":                  false,
+		"parent_id":             nil,
+		"permission_overwrites": []interface{}{},
+		"rate_limit_per_user":   0,
+		"bitrate":               0,
+		"user_limit":            0,
+		"rtc_region":            nil,
+		"flags":                 0,
+	}
+	channel.merge(body)
+	if fakeInt(channel["type"]) == 2 && fakeInt(channel["bitrate"]) == 0 {
+		channel["bitrate"] = 64000
+	}
+	f.fillTagIds(channel)
+	f.channels[channel.str("id")] = channel
+
+	return channel
+}
+
+func (f *fakeDiscord) guildChannels(guildId string) []fakeObject {
+	channels := make([]fakeObject, 0)
+	for _, c := range f.channels {
+		if c.str("guild_id") == guildId {
+			channels = append(channels, c)
+		}
+	}
+	sortFakeObjects(channels)
+
+	return channels
+}
+
+func (f *fakeDiscord) getGuildChannels(r *fakeRequest) (int, interface{}) {
+	if _, ok := f.guilds[r.params[0]]; !ok {
+		return fakeError(http.StatusNotFound, 10004, "Unknown Guild")
+	}
+
+	return http.StatusOK, f.guildChannels(r.params[0])
+}
+
+func (f *fakeDiscord) createChannel(r *fakeRequest) (int, interface{}) {
+	if _, ok := f.guilds[r.params[0]]; !ok {
+		return fakeError(http.StatusNotFound, 10004, "Unknown Guild")
+	}
+
+	return http.StatusCreated, f.addChannel(r.params[0], r.object())
+}
+
+func (f *fakeDiscord) reorderChannels(r *fakeRequest) (int, interface{}) {
+	for _, c := range r.list() {
+		channel, ok := f.channels[c.str("id")]
+		if !ok || channel.str("guild_id") != r.params[0] {
+			return fakeError(http.StatusNotFound, 10003, "Unknown Channel")
+		}
+		delete(c, "lock_permissions")
+		channel.merge(c)
+	}
+
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeDiscord) getChannel(r *fakeRequest) (int, interface{}) {
+	if channel, ok := f.channels[r.params[0]]; ok {
+		return http.StatusOK, channel
+	}
+
+	return fakeError(http.StatusNotFound, 10003, "Unknown Channel")
+}
+
+func (f *fakeDiscord) editChannel(r *fakeRequest) (int, interface{}) {
+	channel, ok := f.channels[r.params[0]]
+	if !ok {
+		return fakeError(http.StatusNotFound, 10003, "Unknown Channel")
+	}
+
+	body := r.object()
+	if metadata, ok := channel["thread_metadata"].(fakeObject); ok {
+		for _, k := range []string{"archived", "auto_archive_duration", "locked", "invitable"} {
+			if v, ok := body[k]; ok {
+				metadata[k] = v
+				delete(body, k)
+			}
+		}
+	}
+	channel.merge(body)
+	f.fillTagIds(channel)
+
+	return http.StatusOK, channel
+}
+
+func (f *fakeDiscord) createThread(r *fakeRequest) (int, interface{}) {
+	parent, ok := f.channels[r.params[0]]
+	if !ok {
+		return fakeError(http.StatusNotFound, 10003, "Unknown Channel")
+	}
+
+	body := r.object()
+	starter, hasMessage := body["message"].(map[string]interface{})
+	isForum := fakeInt(parent["type"]) == 15 || fakeInt(parent["type"]) == 16
+	if isForum != hasMessage {
+		return fakeError(http.StatusBadRequest, 50035, "Invalid Form Body")
+	}
+
+	threadType := 11
+	if v, ok := body["type"]; ok && !isForum {
+		threadType = fakeInt(v)
+	}
+	duration := 4320
+	if v, ok := body["auto_archive_duration"]; ok {
+		duration = fakeInt(v)
+	}
+
+	thread := fakeObject{
+		"id":                  f.newId(),
+		"guild_id":            parent["guild_id"],
+		"parent_id":           parent["id"],
+		"owner_id":            f.botId,
+		"type":                threadType,
+		"name":                body.str("name"),
+		"rate_limit_per_user": fakeInt(body["rate_limit_per_user"]),
+		"applied_tags":        body["applied_tags"],
+		"flags":               0,
+		"thread_metadata": fakeObject{
+			"archived":              false,
+			"auto_archive_duration": duration,
+			"archive_timestamp":     fakeNow(),
+			"locked":                false,
+			"invitable":             body["invitable"] == true,
+		},
+	}
+	f.channels[thread.str("id")] = thread
+
+	if hasMessage {
+		// The starter message of a forum thread shares its ID with the thread.
+		message := fakeObject(starter)
+		message["id"] = thread["id"]
+		message["channel_id"] = thread["id"]
+		message["guild_id"] = thread["guild_id"]
+		message["author"] = f.users[f.botId]
+		message["timestamp"] = fakeNow()
+		message["type"] = 0
+		if message["embeds"] == nil {
+			message["embeds"] = []interface{}{}
+		}
+		f.messages[thread.str("id")] = message
+	}
+
+	return http.StatusCreated, thread
+}
+
+// fillTagIds gives the new tags of a forum channel an ID.
+func (f *fakeDiscord) fillTagIds(channel fakeObject) {
+	tags, _ := channel["available_tags"].([]interface{})
+	for _, t := range tags {
+		if tag := t.(map[string]interface{}); tag["id"] == nil || tag["id"] == "" {
+			tag["id"] = f.newId()
+		}
+	}
+}
+
+func (f *fakeDiscord) deleteChannel(r *fakeRequest) (int, interface{}) {
+	channel, ok := f.channels[r.params[0]]
+	if !ok {
+		return fakeError(http.StatusNotFound, 10003, "Unknown Channel")
+	}
+	delete(f.channels, r.params[0])
+
+	return http.StatusOK, channel
+}
+
+func (f *fakeDiscord) setPermission(r *fakeRequest) (int, interface{}) {
+	channel, ok := f.channels[r.params[0]]
+	if !ok {
+		return fakeError(http.StatusNotFound, 10003, "Unknown Channel")
+	}
+
+	body := r.object()
+	overwrite := fakeObject{"id": r.params[1], "type": body["type"], "allow": body["allow"], "deny": body["deny"]}
+	overwrites := channel["permission_overwrites"].([]interface{})
+	for i, o := range overwrites {
+		if o.(fakeObject).str("id") == r.params[1] {
+			overwrites[i] = overwrite
+			return http.StatusNoContent, nil
+		}
+	}
+	channel["permission_overwrites"] = append(overwrites, overwrite)
+
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeDiscord) deletePermission(r *fakeRequest) (int, interface{}) {
+	channel, ok := f.channels[r.params[0]]
+	if !ok {
+		return fakeError(http.StatusNotFound, 10003, "Unknown Channel")
+	}
+
+	overwrites := make([]interface{}, 0)
+	for _, o := range channel["permission_overwrites"].([]interface{}) {
+		if o.(fakeObject).str("id") != r.params[1] {
+			overwrites = append(overwrites, o)
+		}
+	}
+	channel["permission_overwrites"] = overwrites
+
+	return http.StatusNoContent, nil
+}
+
+// Roles
+
+func (f *fakeDiscord) newRole(id string, body fakeObject) fakeObject {
+	role := fakeObject{
+		"id":            id,
+		"name":          "new role",
+		"color":         0,
+		"hoist":         false,
+		"position":      1,
+		"permissions":   "0",
+		"managed":       false,
+		"mentionable":   false,
+		"icon":          nil,
+		"unicode_emoji": nil,
+		"flags":         0,
+	}
+
+	return role.merge(body)
+}
+
+func (f *fakeDiscord) addRole(guildId string, body fakeObject) fakeObject {
+	// Like Discord, new roles go right above @everyone and push the others up.
+	for _, r := range f.roles[guildId] {
+		if p := fakeInt(r["position"]); p > 0 {
+			r["position"] = p + 1
+		}
+	}
+	role := f.newRole(f.newId(), body)
+	f.roles[guildId] = append(f.roles[guildId], role)
+
+	return role
+}
+
+func (f *fakeDiscord) sortedRoles(guildId string) []fakeObject {
+	roles := append([]fakeObject{}, f.roles[guildId]...)
+	sortFakeObjects(roles)
+
+	return roles
+}
+
+func (f *fakeDiscord) findRole(guildId string, roleId string) fakeObject {
+	for _, role := range f.roles[guildId] {
+		if role.str("id") == roleId {
+			return role
+		}
+	}
+
+	return nil
+}
+
+func (f *fakeDiscord) getRoles(r *fakeRequest) (int, interface{}) {
+	if _, ok := f.guilds[r.params[0]]; !ok {
+		return fakeError(http.StatusNotFound, 10004, "Unknown Guild")
+	}
+
+	return http.StatusOK, f.sortedRoles(r.params[0])
+}
+
+func (f *fakeDiscord) createRole(r *fakeRequest) (int, interface{}) {
+	if _, ok := f.guilds[r.params[0]]; !ok {
+		return fakeError(http.StatusNotFound, 10004, "Unknown Guild")
+	}
+
+	return http.StatusOK, f.addRole(r.params[0], r.object())
+}
+
+func (f *fakeDiscord) reorderRoles(r *fakeRequest) (int, interface{}) {
+	if _, ok := f.guilds[r.params[0]]; !ok {
+		return fakeError(http.StatusNotFound, 10004, "Unknown Guild")
+	}
+
+	for _, p := range r.list() {
+		role := f.findRole(r.params[0], p.str("id"))
+		if role == nil {
+			return fakeError(http.StatusNotFound, 10011, "Unknown Role")
+		}
+		role["position"] = fakeInt(p["position"])
+	}
+
+	return http.StatusOK, f.sortedRoles(r.params[0])
+}
+
+func (f *fakeDiscord) editRole(r *fakeRequest) (int, interface{}) {
+	role := f.findRole(r.params[0], r.params[1])
+	if role == nil {
+		return fakeError(http.StatusNotFound, 10011, "Unknown Role")
+	}
+
+	return http.StatusOK, role.merge(r.object())
+}
+
+func (f *fakeDiscord) deleteRole(r *fakeRequest) (int, interface{}) {
+	if f.findRole(r.params[0], r.params[1]) == nil {
+		return fakeError(http.StatusNotFound, 10011, "Unknown Role")
+	}
+
+	roles := make([]fakeObject, 0)
+	for _, role := range f.roles[r.params[0]] {
+		if role.str("id") != r.params[1] {
+			roles = append(roles, role)
+		}
+	}
+	f.roles[r.params[0]] = roles
+
+	return http.StatusNoContent, nil
+}
+
+// Members
+
+func (f *fakeDiscord) addMember(guildId string, userId string) fakeObject {
+	member := fakeObject{
+		"user":          f.users[userId],
+		"nick":          nil,
+		"avatar":        nil,
+		"roles":         []interface{}{},
+		"joined_at":     fakeNow(),
+		"premium_since": nil,
+		"deaf":          false,
+		"mute":          false,
+		"flags":         0,
+		"pending":       false,
+	}
+	f.members[guildId] = append(f.members[guildId], member)
+
+	return member
+}
+
+func (f *fakeDiscord) findMember(guildId string, userId string) fakeObject {
+	for _, member := range f.members[guildId] {
+		if member["user"].(fakeObject).str("id") == userId {
+			return member
+		}
+	}
+
+	return nil
+}
+
+func (f *fakeDiscord) getMembers(r *fakeRequest) (int, interface{}) {
+	if _, ok := f.guilds[r.params[0]]; !ok {
+		return fakeError(http.StatusNotFound, 10004, "Unknown Guild")
+	}
+
+	limit := 1
+	if v := r.query.Get("limit"); v != "" {
+		limit = fakeInt(v)
+	}
+	after := r.query.Get("after")
+
+	members := make([]fakeObject, 0)
+	for _, member := range f.members[r.params[0]] {
+		if id := member["user"].(fakeObject).str("id"); after == "" || len(id) > len(after) || (len(id) == len(after) && id > after) {
+			members = append(members, member)
+		}
+	}
+	if len(members) > limit {
+		members = members[:limit]
+	}
+
+	return http.StatusOK, members
+}
+
+func (f *fakeDiscord) searchMembers(r *fakeRequest) (int, interface{}) {
+	if _, ok := f.guilds[r.params[0]]; !ok {
+		return fakeError(http.StatusNotFound, 10004, "Unknown Guild")
+	}
+
+	limit := 1
+	if v := r.query.Get("limit"); v != "" {
+		limit = fakeInt(v)
+	}
+	query := strings.ToLower(r.query.Get("query"))
+
+	members := make([]fakeObject, 0)
+	for _, member := range f.members[r.params[0]] {
+		username := strings.ToLower(member["user"].(fakeObject).str("username"))
+		nick := strings.ToLower(member.str("nick"))
+		if len(members) < limit && (strings.HasPrefix(username, query) || (nick != "" && strings.HasPrefix(nick, query))) {
+			members = append(members, member)
+		}
+	}
+
+	return http.StatusOK, members
+}
+
+func (f *fakeDiscord) getMember(r *fakeRequest) (int, interface{}) {
+	if member := f.findMember(r.params[0], r.params[1]); member != nil {
+		return http.StatusOK, member
+	}
+
+	return fakeError(http.StatusNotFound, 10007, "Unknown Member")
+}
+
+func (f *fakeDiscord) editMember(r *fakeRequest) (int, interface{}) {
+	member := f.findMember(r.params[0], r.params[1])
+	if member == nil {
+		return fakeError(http.StatusNotFound, 10007, "Unknown Member")
+	}
+
+	body := r.object()
+	if nick, ok := body["nick"]; ok && nick == "" {
+		body["nick"] = nil
+	}
+
+	return http.StatusOK, member.merge(body)
+}
+
+// Messages
+
+func (f *fakeDiscord) findMessage(channelId string, messageId string) fakeObject {
+	if message, ok := f.messages[messageId]; ok && message.str("channel_id") == channelId {
+		return message
+	}
+
+	return nil
+}
+
+func (f *fakeDiscord) createMessage(r *fakeRequest) (int, interface{}) {
+	channel, ok := f.channels[r.params[0]]
+	if !ok {
+		return fakeError(http.StatusNotFound, 10003, "Unknown Channel")
+	}
+
+	body := r.object()
+	message := fakeObject{
+		"id":               f.newId(),
+		"channel_id":       channel["id"],
+		"guild_id":         channel["guild_id"],
+		"author":           f.users[f.botId],
+		"content":          body.str("content"),
+		"embeds":           []interface{}{},
+		"components":       []interface{}{},
+		"attachments":      []interface{}{},
+		"mentions":         []interface{}{},
+		"mention_roles":    []interface{}{},
+		"tts":              body["tts"] == true,
+		"timestamp":        fakeNow(),
+		"edited_timestamp": nil,
+		"pinned":           false,
+		"type":             0,
+		"flags":            0,
+	}
+	if embeds, ok := body["embeds"].([]interface{}); ok {
+		message["embeds"] = embeds
+	}
+	f.messages[message.str("id")] = message
+
+	return http.StatusOK, message
+}
+
+func (f *fakeDiscord) getMessage(r *fakeRequest) (int, interface{}) {
+	if message := f.findMessage(r.params[0], r.params[1]); message != nil {
+		return http.StatusOK, message
+	}
+
+	return fakeError(http.StatusNotFound, 10008, "Unknown Message")
+}
+
+func (f *fakeDiscord) editMessage(r *fakeRequest) (int, interface{}) {
+	message := f.findMessage(r.params[0], r.params[1])
+	if message == nil {
+		return fakeError(http.StatusNotFound, 10008, "Unknown Message")
+	}
+
+	body := r.object()
+	for _, k := range []string{"content", "embeds", "components", "flags"} {
+		if v, ok := body[k]; ok && v != nil {
+			message[k] = v
+		}
+	}
+	message["edited_timestamp"] = fakeNow()
+
+	return http.StatusOK, message
+}
+
+func (f *fakeDiscord) deleteMessage(r *fakeRequest) (int, interface{}) {
+	if f.findMessage(r.params[0], r.params[1]) == nil {
+		return fakeError(http.StatusNotFound, 10008, "Unknown Message")
+	}
+	delete(f.messages, r.params[1])
+
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeDiscord) pinMessage(r *fakeRequest) (int, interface{}) {
+	message := f.findMessage(r.params[0], r.params[1])
+	if message == nil {
+		return fakeError(http.StatusNotFound, 10008, "Unknown Message")
+	}
+	message["pinned"] = true
+
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeDiscord) unpinMessage(r *fakeRequest) (int, interface{}) {
+	message := f.findMessage(r.params[0], r.params[1])
+	if message == nil {
+		return fakeError(http.StatusNotFound, 10008, "Unknown Message")
+	}
+	message["pinned"] = false
+
+	return http.StatusNoContent, nil
+}
+
+// Webhooks
+
+func (f *fakeDiscord) getChannelWebhooks(r *fakeRequest) (int, interface{}) {
+	if _, ok := f.channels[r.params[0]]; !ok {
+		return fakeError(http.StatusNotFound, 10003, "Unknown Channel")
+	}
+
+	webhooks := make([]fakeObject, 0)
+	for _, w := range f.webhooks {
+		if w.str("channel_id") == r.params[0] {
+			webhooks = append(webhooks, w)
+		}
+	}
+
+	return http.StatusOK, webhooks
+}
+
+func (f *fakeDiscord) createWebhook(r *fakeRequest) (int, interface{}) {
+	channel, ok := f.channels[r.params[0]]
+	if !ok {
+		return fakeError(http.StatusNotFound, 10003, "Unknown Channel")
+	}
+
+	id := f.newId()
+	webhook := fakeObject{
+		"id":         id,
+		"type":       1,
+		"guild_id":   channel["guild_id"],
+		"channel_id": channel["id"],
+		"user":       f.users[f.botId],
+		"token":      "token-" + id,
+	}
+	webhook.merge(r.object())
+	f.webhooks[id] = webhook
+
+	return http.StatusOK, webhook
+}
+
+func (f *fakeDiscord) getWebhook(r *fakeRequest) (int, interface{}) {
+	if webhook, ok := f.webhooks[r.params[0]]; ok {
+		return http.StatusOK, webhook
+	}
+
+	return fakeError(http.StatusNotFound, 10015, "Unknown Webhook")
+}
+
+func (f *fakeDiscord) editWebhook(r *fakeRequest) (int, interface{}) {
+	webhook, ok := f.webhooks[r.params[0]]
+	if !ok {
+		return fakeError(http.StatusNotFound, 10015, "Unknown Webhook")
+	}
+
+	return http.StatusOK, webhook.merge(r.object())
+}
+
+func (f *fakeDiscord) deleteWebhook(r *fakeRequest) (int, interface{}) {
+	if _, ok := f.webhooks[r.params[0]]; !ok {
+		return fakeError(http.StatusNotFound, 10015, "Unknown Webhook")
+	}
+	delete(f.webhooks, r.params[0])
+
+	return http.StatusNoContent, nil
+}
+
+// Invites
+
+func (f *fakeDiscord) getChannelInvites(r *fakeRequest) (int, interface{}) {
+	if _, ok := f.channels[r.params[0]]; !ok {
+		return fakeError(http.StatusNotFound, 10003, "Unknown Channel")
+	}
+
+	invites := make([]fakeObject, 0)
+	for _, i := range f.invites {
+		if i["channel"].(fakeObject).str("id") == r.params[0] {
+			invites = append(invites, i)
+		}
+	}
+
+	return http.StatusOK, invites
+}
+
+func (f *fakeDiscord) createInvite(r *fakeRequest) (int, interface{}) {
+	channel, ok := f.channels[r.params[0]]
+	if !ok {
+		return fakeError(http.StatusNotFound, 10003, "Unknown Channel")
+	}
+	guild := f.guilds[channel.str("guild_id")]
+
+	body := r.object()
+	id, _ := strconv.ParseUint(f.newId(), 10, 64)
+	invite := fakeObject{
+		"code":       strconv.FormatUint(id, 36),
+		"guild":      fakeObject{"id": guild["id"], "name": guild["name"]},
+		"channel":    fakeObject{"id": channel["id"], "name": channel["name"], "type": channel["type"]},
+		"inviter":    f.users[f.botId],
+		"max_age":    fakeInt(body["max_age"]),
+		"max_uses":   fakeInt(body["max_uses"]),
+		"temporary":  body["temporary"] == true,
+		"unique":     body["unique"] == true,
+		"uses":       0,
+		"created_at": fakeNow(),
+	}
+	f.invites[invite.str("code")] = invite
+
+	return http.StatusOK, invite
+}
+
+func (f *fakeDiscord) getInvite(r *fakeRequest) (int, interface{}) {
+	if invite, ok := f.invites[r.params[0]]; ok {
+		return http.StatusOK, invite
+	}
+
+	return fakeError(http.StatusNotFound, 10006, "Unknown Invite")
+}
+
+func (f *fakeDiscord) deleteInvite(r *fakeRequest) (int, interface{}) {
+	invite, ok := f.invites[r.params[0]]
+	if !ok {
+		return fakeError(http.StatusNotFound, 10006, "Unknown Invite")
+	}
+	delete(f.invites, r.params[0])
+
+	return http.StatusOK, invite
+}
+
+// Stage instances
+
+func (f *fakeDiscord) createStageInstance(r *fakeRequest) (int, interface{}) {
+	body := r.object()
+	channel, ok := f.channels[body.str("channel_id")]
+	if !ok {
+		return fakeError(http.StatusNotFound, 10003, "Unknown Channel")
+	}
+	if fakeInt(channel["type"]) != 13 {
+		return fakeError(http.StatusBadRequest, 50024, "Cannot execute action on this channel type")
+	}
+	if _, ok := f.stages[channel.str("id")]; ok {
+		return fakeError(http.StatusBadRequest, 150006, "Stage already open")
+	}
+
+	stage := fakeObject{
+		"id":            f.newId(),
+		"guild_id":      channel["guild_id"],
+		"channel_id":    channel["id"],
+		"topic":         body.str("topic"),
+		"privacy_level": 2,
+	}
+	if v, ok := body["privacy_level"]; ok {
+		stage["privacy_level"] = fakeInt(v)
+	}
+	f.stages[channel.str("id")] = stage
+
+	return http.StatusOK, stage
+}
+
+func (f *fakeDiscord) getStageInstance(r *fakeRequest) (int, interface{}) {
+	if stage, ok := f.stages[r.params[0]]; ok {
+		return http.StatusOK, stage
+	}
+
+	return fakeError(http.StatusNotFound, 10067, "Unknown Stage Instance")
+}
+
+func (f *fakeDiscord) editStageInstance(r *fakeRequest) (int, interface{}) {
+	stage, ok := f.stages[r.params[0]]
+	if !ok {
+		return fakeError(http.StatusNotFound, 10067, "Unknown Stage Instance")
+	}
+
+	return http.StatusOK, stage.merge(r.object())
+}
+
+func (f *fakeDiscord) deleteStageInstance(r *fakeRequest) (int, interface{}) {
+	if _, ok := f.stages[r.params[0]]; !ok {
+		return fakeError(http.StatusNotFound, 10067, "Unknown Stage Instance")
+	}
+	delete(f.stages, r.params[0])
+
+	return http.StatusNoContent, nil
+}
+
+// Scheduled events
+
+func (f *fakeDiscord) findScheduledEvent(guildId string, eventId string) fakeObject {
+	if event, ok := f.events[eventId]; ok && event.str("guild_id") == guildId {
+		return event
+	}
+
+	return nil
+}
+
+func (f *fakeDiscord) getScheduledEvents(r *fakeRequest) (int, interface{}) {
+	if _, ok := f.guilds[r.params[0]]; !ok {
+		return fakeError(http.StatusNotFound, 10004, "Unknown Guild")
+	}
+
+	events := make([]fakeObject, 0)
+	for _, event := range f.events {
+		if event.str("guild_id") == r.params[0] {
+			events = append(events, event)
+		}
+	}
+
+	return http.StatusOK, events
+}
+
+func (f *fakeDiscord) createScheduledEvent(r *fakeRequest) (int, interface{}) {
+	if _, ok := f.guilds[r.params[0]]; !ok {
+		return fakeError(http.StatusNotFound, 10004, "Unknown Guild")
+	}
+
+	event := fakeObject{
+		"id":                   f.newId(),
+		"guild_id":             r.params[0],
+		"channel_id":           nil,
+		"creator_id":           f.botId,
+		"description":          nil,
+		"scheduled_end_time":   nil,
+		"status":               1,
+		"entity_id":            nil,
+		"entity_metadata":      nil,
+		"image":                nil,
+		"scheduled_start_time": nil,
+	}
+	event.merge(r.object())
+	f.events[event.str("id")] = event
+
+	return http.StatusOK, event
+}
+
+func (f *fakeDiscord) getScheduledEvent(r *fakeRequest) (int, interface{}) {
+	if event := f.findScheduledEvent(r.params[0], r.params[1]); event != nil {
+		return http.StatusOK, event
+	}
+
+	return fakeError(http.StatusNotFound, 10070, "Unknown Guild Scheduled Event")
+}
+
+func (f *fakeDiscord) editScheduledEvent(r *fakeRequest) (int, interface{}) {
+	event := f.findScheduledEvent(r.params[0], r.params[1])
+	if event == nil {
+		return fakeError(http.StatusNotFound, 10070, "Unknown Guild Scheduled Event")
+	}
+
+	return http.StatusOK, event.merge(r.object())
+}
+
+func (f *fakeDiscord) deleteScheduledEvent(r *fakeRequest) (int, interface{}) {
+	if f.findScheduledEvent(r.params[0], r.params[1]) == nil {
+		return fakeError(http.StatusNotFound, 10070, "Unknown Guild Scheduled Event")
+	}
+	delete(f.events, r.params[1])
+
+	return http.StatusNoContent, nil
+}
+
+// AutoMod rules
+
+func (f *fakeDiscord) findAutomodRule(guildId string, ruleId string) fakeObject {
+	if rule, ok := f.automod[ruleId]; ok && rule.str("guild_id") == guildId {
+		return rule
+	}
+
+	return nil
+}
+
+func (f *fakeDiscord) getAutomodRules(r *fakeRequest) (int, interface{}) {
+	if _, ok := f.guilds[r.params[0]]; !ok {
+		return fakeError(http.StatusNotFound, 10004, "Unknown Guild")
+	}
+
+	rules := make([]fakeObject, 0)
+	for _, rule := range f.automod {
+		if rule.str("guild_id") == r.params[0] {
+			rules = append(rules, rule)
+		}
+	}
+
+	return http.StatusOK, rules
+}
+
+func (f *fakeDiscord) createAutomodRule(r *fakeRequest) (int, interface{}) {
+	if _, ok := f.guilds[r.params[0]]; !ok {
+		return fakeError(http.StatusNotFound, 10004, "Unknown Guild")
+	}
+
+	rule := fakeObject{
+		"id":               f.newId(),
+		"guild_id":         r.params[0],
+		"creator_id":       f.botId,
+		"trigger_metadata": fakeObject{},
+		"enabled":          false,
+		"exempt_roles":     []interface{}{},
+		"exempt_channels":  []interface{}{},
+	}
+	rule.merge(r.object())
+	f.automod[rule.str("id")] = rule
+
+	return http.StatusOK, rule
+}
+
+func (f *fakeDiscord) getAutomodRule(r *fakeRequest) (int, interface{}) {
+	if rule := f.findAutomodRule(r.params[0], r.params[1]); rule != nil {
+		return http.StatusOK, rule
+	}
+
+	return fakeError(http.StatusNotFound, 10066, "Unknown Auto Moderation Rule")
+}
+
+func (f *fakeDiscord) editAutomodRule(r *fakeRequest) (int, interface{}) {
+	rule := f.findAutomodRule(r.params[0], r.params[1])
+	if rule == nil {
+		return fakeError(http.StatusNotFound, 10066, "Unknown Auto Moderation Rule")
+	}
+
+	return http.StatusOK, rule.merge(r.object())
+}
+
+func (f *fakeDiscord) deleteAutomodRule(r *fakeRequest) (int, interface{}) {
+	if f.findAutomodRule(r.params[0], r.params[1]) == nil {
+		return fakeError(http.StatusNotFound, 10066, "Unknown Auto Moderation Rule")
+	}
+	delete(f.automod, r.params[1])
+
+	return http.StatusNoContent, nil
+}
+
+// Emojis
+
+func (f *fakeDiscord) guildEmojis(guildId string) []fakeObject {
+	if emojis, ok := f.emojis[guildId]; ok {
+		return emojis
+	}
+
+	return []fakeObject{}
+}
+
+func (f *fakeDiscord) findEmoji(guildId string, emojiId string) fakeObject {
+	for _, emoji := range f.emojis[guildId] {
+		if emoji.str("id") == emojiId {
+			return emoji
+		}
+	}
+
+	return nil
+}
+
+func (f *fakeDiscord) getEmojis(r *fakeRequest) (int, interface{}) {
+	if _, ok := f.guilds[r.params[0]]; !ok {
+		return fakeError(http.StatusNotFound, 10004, "Unknown Guild")
+	}
+
+	return http.StatusOK, f.guildEmojis(r.params[0])
+}
+
+func (f *fakeDiscord) createEmoji(r *fakeRequest) (int, interface{}) {
+	if _, ok := f.guilds[r.params[0]]; !ok {
+		return fakeError(http.StatusNotFound, 10004, "Unknown Guild")
+	}
+
+	body := r.object()
+	emoji := fakeObject{
+		"id":             f.newId(),
+		"name":           body["name"],
+		"roles":          []interface{}{},
+		"require_colons": true,
+		"managed":        false,
+		"animated":       strings.HasPrefix(body.str("image"), "data:image/gif"),
+		"available":      true,
+	}
+	if roles, ok := body["roles"]; ok && roles != nil {
+		emoji["roles"] = roles
+	}
+	f.emojis[r.params[0]] = append(f.emojis[r.params[0]], emoji)
+
+	return http.StatusCreated, emoji
+}
+
+func (f *fakeDiscord) getEmoji(r *fakeRequest) (int, interface{}) {
+	if emoji := f.findEmoji(r.params[0], r.params[1]); emoji != nil {
+		return http.StatusOK, emoji
+	}
+
+	return fakeError(http.StatusNotFound, 10014, "Unknown Emoji")
+}
+
+func (f *fakeDiscord) editEmoji(r *fakeRequest) (int, interface{}) {
+	emoji := f.findEmoji(r.params[0], r.params[1])
+	if emoji == nil {
+		return fakeError(http.StatusNotFound, 10014, "Unknown Emoji")
+	}
+
+	return http.StatusOK, emoji.merge(r.object())
+}
+
+func (f *fakeDiscord) deleteEmoji(r *fakeRequest) (int, interface{}) {
+	emojis := f.emojis[r.params[0]]
+	for i, emoji := range emojis {
+		if emoji.str("id") == r.params[1] {
+			f.emojis[r.params[0]] = append(emojis[:i:i], emojis[i+1:]...)
+
+			return http.StatusNoContent, nil
+		}
+	}
+
+	return fakeError(http.StatusNotFound, 10014, "Unknown Emoji")
+}
+
+// Stickers
+
+var fakeStickerFormats = map[string]int{"image/png": 1, "application/json": 3, "image/gif": 4}
+
+func (f *fakeDiscord) guildStickers(guildId string) []fakeObject {
+	if stickers, ok := f.stickers[guildId]; ok {
+		return stickers
+	}
+
+	return []fakeObject{}
+}
+
+func (f *fakeDiscord) findSticker(guildId string, stickerId string) fakeObject {
+	for _, sticker := range f.stickers[guildId] {
+		if sticker.str("id") == stickerId {
+			return sticker
+		}
+	}
+
+	return nil
+}
+
+func (f *fakeDiscord) getStickers(r *fakeRequest) (int, interface{}) {
+	if _, ok := f.guilds[r.params[0]]; !ok {
+		return fakeError(http.StatusNotFound, 10004, "Unknown Guild")
+	}
+
+	return http.StatusOK, f.guildStickers(r.params[0])
+}
+
+func (f *fakeDiscord) createSticker(r *fakeRequest) (int, interface{}) {
+	if _, ok := f.guilds[r.params[0]]; !ok {
+		return fakeError(http.StatusNotFound, 10004, "Unknown Guild")
+	}
+
+	fields, files := r.form()
+	format, ok := fakeStickerFormats[files["file"]]
+	if !ok {
+		return fakeError(http.StatusBadRequest, 50046, "Invalid Asset")
+	}
+
+	sticker := fakeObject{
+		"id":          f.newId(),
+		"guild_id":    r.params[0],
+		"type":        2,
+		"format_type": format,
+		"available":   true,
+		"user":        f.users[f.botId],
+	}
+	sticker.merge(fields)
+	f.stickers[r.params[0]] = append(f.stickers[r.params[0]], sticker)
+
+	return http.StatusCreated, sticker
+}
+
+func (f *fakeDiscord) getSticker(r *fakeRequest) (int, interface{}) {
+	if sticker := f.findSticker(r.params[0], r.params[1]); sticker != nil {
+		return http.StatusOK, sticker
+	}
+
+	return fakeError(http.StatusNotFound, 10060, "Unknown Sticker")
+}
+
+func (f *fakeDiscord) editSticker(r *fakeRequest) (int, interface{}) {
+	sticker := f.findSticker(r.params[0], r.params[1])
+	if sticker == nil {
+		return fakeError(http.StatusNotFound, 10060, "Unknown Sticker")
+	}
+
+	return http.StatusOK, sticker.merge(r.object())
+}
+
+func (f *fakeDiscord) deleteSticker(r *fakeRequest) (int, interface{}) {
+	stickers := f.stickers[r.params[0]]
+	for i, sticker := range stickers {
+		if sticker.str("id") == r.params[1] {
+			f.stickers[r.params[0]] = append(stickers[:i:i], stickers[i+1:]...)
+
+			return http.StatusNoContent, nil
+		}
+	}
+
+	return fakeError(http.StatusNotFound, 10060, "Unknown Sticker")
+}
+
+// Bans
+
+func (f *fakeDiscord) findBan(guildId string, userId string) int {
+	for i, ban := range f.bans[guildId] {
+		if ban["user"].(fakeObject).str("id") == userId {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (f *fakeDiscord) getBans(r *fakeRequest) (int, interface{}) {
+	if _, ok := f.guilds[r.params[0]]; !ok {
+		return fakeError(http.StatusNotFound, 10004, "Unknown Guild")
+	}
+
+	limit := 1000
+	if v := r.query.Get("limit"); v != "" {
+		limit = fakeInt(v)
+	}
+	after := r.query.Get("after")
+
+	bans := make([]fakeObject, 0)
+	for _, ban := range f.bans[r.params[0]] {
+		if id := ban["user"].(fakeObject).str("id"); after == "" || len(id) > len(after) || (len(id) == len(after) && id > after) {
+			bans = append(bans, ban)
+		}
+	}
+	if len(bans) > limit {
+		bans = bans[:limit]
+	}
+
+	return http.StatusOK, bans
+}
+
+func (f *fakeDiscord) getBan(r *fakeRequest) (int, interface{}) {
+	if i := f.findBan(r.params[0], r.params[1]); i >= 0 {
+		return http.StatusOK, f.bans[r.params[0]][i]
+	}
+
+	return fakeError(http.StatusNotFound, 10026, "Unknown Ban")
+}
+
+func (f *fakeDiscord) createBan(r *fakeRequest) (int, interface{}) {
+	if _, ok := f.guilds[r.params[0]]; !ok {
+		return fakeError(http.StatusNotFound, 10004, "Unknown Guild")
+	}
+	user, ok := f.users[r.params[1]]
+	if !ok {
+		return fakeError(http.StatusNotFound, 10013, "Unknown User")
+	}
+
+	if f.findBan(r.params[0], r.params[1]) < 0 {
+		var reason interface{}
+		if v := r.auditLogReason; v != "" {
+			reason = v
+		}
+		f.bans[r.params[0]] = append(f.bans[r.params[0]], fakeObject{"user": user, "reason": reason})
+	}
+
+	members := f.members[r.params[0]]
+	for i, member := range members {
+		if member["user"].(fakeObject).str("id") == r.params[1] {
+			f.members[r.params[0]] = append(members[:i:i], members[i+1:]...)
+			break
+		}
+	}
+
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeDiscord) deleteBan(r *fakeRequest) (int, interface{}) {
+	i := f.findBan(r.params[0], r.params[1])
+	if i < 0 {
+		return fakeError(http.StatusNotFound, 10026, "Unknown Ban")
+	}
+	bans := f.bans[r.params[0]]
+	f.bans[r.params[0]] = append(bans[:i:i], bans[i+1:]...)
+
+	return http.StatusNoContent, nil
+}
+
+// Application commands
+
+func (f *fakeDiscord) getCurrentApplication(r *fakeRequest) (int, interface{}) {
+	bot := f.users[f.botId]
+
+	return http.StatusOK, fakeObject{"id": f.botId, "name": bot["username"], "bot": bot}
+}
+
+// commandScope splits the params of a single command route into the
+// application, server and command IDs. The server ID is empty for global
+// commands.
+func commandScope(params []string) (string, string, string) {
+	if len(params) == 3 {
+		return params[0], params[1], params[2]
+	}
+
+	return params[0], "", params[1]
+}
+
+// commandsScope is commandScope for routes listing commands.
+func commandsScope(params []string) (string, string) {
+	if len(params) == 2 {
+		return params[0], params[1]
+	}
+
+	return params[0], ""
+}
+
+func (f *fakeDiscord) findCommand(appId string, serverId string, commandId string) fakeObject {
+	if command, ok := f.commands[commandId]; ok && command.str("application_id") == appId && command.str("guild_id") == serverId {
+		return command
+	}
+
+	return nil
+}
+
+func (f *fakeDiscord) getCommands(r *fakeRequest) (int, interface{}) {
+	appId, serverId := commandsScope(r.params)
+
+	commands := make([]fakeObject, 0)
+	for _, command := range f.commands {
+		if command.str("application_id") == appId && command.str("guild_id") == serverId {
+			commands = append(commands, command)
+		}
+	}
+
+	return http.StatusOK, commands
+}
+
+func (f *fakeDiscord) createCommand(r *fakeRequest) (int, interface{}) {
+	appId, serverId := commandsScope(r.params)
+	if appId != f.botId {
+		return fakeError(http.StatusForbidden, 50001, "Missing Access")
+	}
+	if _, ok := f.guilds[serverId]; serverId != "" && !ok {
+		return fakeError(http.StatusNotFound, 10004, "Unknown Guild")
+	}
+
+	body := r.object()
+	if body["type"] == nil {
+		body["type"] = json.Number("1")
+	}
+
+	// Creating a command with the name of an existing one overwrites it.
+	for _, command := range f.commands {
+		if command.str("application_id") == appId && command.str("guild_id") == serverId &&
+			command.str("name") == body.str("name") && fakeInt(command["type"]) == fakeInt(body["type"]) {
+			command.merge(body)
+			command["version"] = f.newId()
+
+			return http.StatusOK, command
+		}
+	}
+
+	command := fakeObject{
+		"id":                         f.newId(),
+		"application_id":             appId,
+		"version":                    f.newId(),
+		"default_member_permissions": nil,
+		"nsfw":                       false,
+		"description":                "",
+		"name_localizations":         nil,
+		"description_localizations":  nil,
+		"options":                    []interface{}{},
+		"contexts":                   nil,
+	}
+	if serverId != "" {
+		command["guild_id"] = serverId
+	}
+	command.merge(body)
+	f.commands[command.str("id")] = command
+
+	return http.StatusCreated, command
+}
+
+func (f *fakeDiscord) getCommand(r *fakeRequest) (int, interface{}) {
+	if command := f.findCommand(commandScope(r.params)); command != nil {
+		return http.StatusOK, command
+	}
+
+	return fakeError(http.StatusNotFound, 10063, "Unknown application command")
+}
+
+func (f *fakeDiscord) editCommand(r *fakeRequest) (int, interface{}) {
+	command := f.findCommand(commandScope(r.params))
+	if command == nil {
+		return fakeError(http.StatusNotFound, 10063, "Unknown application command")
+	}
+	command.merge(r.object())
+	command["version"] = f.newId()
+
+	return http.StatusOK, command
+}
+
+func (f *fakeDiscord) deleteCommand(r *fakeRequest) (int, interface{}) {
+	if f.findCommand(commandScope(r.params)) == nil {
+		return fakeError(http.StatusNotFound, 10063, "Unknown application command")
+	}
+	delete(f.commands, r.params[len(r.params)-1])
+
+	return http.StatusNoContent, nil
+}
+
+func (f *fakeDiscord) getCommandPermissions(r *fakeRequest) (int, interface{}) {
+	if permissions, ok := f.commandPermissions[r.params[1]+":"+r.params[2]]; ok {
+		return http.StatusOK, permissions
+	}
+
+	return fakeError(http.StatusNotFound, 10066, "Unknown application command permissions")
+}
+
+func (f *fakeDiscord) editCommandPermissions(r *fakeRequest) (int, interface{}) {
+	appId, serverId, commandId := r.params[0], r.params[1], r.params[2]
+	// Bots can't edit command permissions, only users with an OAuth2 token.
+	if !r.bearer {
+		return fakeError(http.StatusUnauthorized, 50001, "Missing Access")
+	}
+	if commandId != appId && f.findCommand(appId, "", commandId) == nil && f.findCommand(appId, serverId, commandId) == nil {
+		return fakeError(http.StatusNotFound, 10063, "Unknown application command")
+	}
+
+	permissions := fakeObject{
+		"id":             commandId,
+		"application_id": appId,
+		"guild_id":       serverId,
+	}
+	permissions.merge(r.object())
+	f.commandPermissions[serverId+":"+commandId] = permissions
+
+	return http.StatusOK, permissions
+}
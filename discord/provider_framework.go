@@ -0,0 +1,212 @@
+package discord
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// frameworkProvider is the provider, built on terraform-plugin-framework.
+type frameworkProvider struct {
+	version string
+	// baseURL overrides the Discord endpoint, see Config.BaseURL.
+	baseURL string
+}
+
+type frameworkProviderModel struct {
+	Token             types.String `tfsdk:"token"`
+	ClientID          types.String `tfsdk:"client_id"`
+	Secret            types.String `tfsdk:"secret"`
+	MaxRetries        types.Int64  `tfsdk:"max_retries"`
+	RequestTimeout    types.Int64  `tfsdk:"request_timeout"`
+	RateLimitStrategy types.String `tfsdk:"rate_limit_strategy"`
+}
+
+func newFrameworkProvider(version string) func() provider.Provider {
+	return func() provider.Provider {
+		return &frameworkProvider{version: version}
+	}
+}
+
+func (p *frameworkProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "discord"
+	resp.Version = p.version
+}
+
+func (p *frameworkProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"token": schema.StringAttribute{
+				Optional:    true,
+				Description: "Discord API token, without the `Bot` prefix. This can be found in the Discord Developer Portal. This can also be set via the `DISCORD_TOKEN` environment variable.",
+			},
+			"client_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "OAuth app client ID. Used as the default `application_id` of application commands.",
+			},
+			"secret": schema.StringAttribute{
+				Optional:    true,
+				Description: "OAuth app secret. Currently unused.",
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional:    true,
+				Validators:  []validator.Int64{int64validator.AtLeast(0)},
+				Description: "How many times a request is retried when Discord rate limits it or fails to handle it. Requests that aren't idempotent, like creating a channel, are only retried when rate limited.",
+			},
+			"request_timeout": schema.Int64Attribute{
+				Optional:    true,
+				Validators:  []validator.Int64{int64validator.AtLeast(0)},
+				Description: "How many seconds a single request may take before it is aborted. `0` disables the timeout.",
+			},
+			"rate_limit_strategy": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.OneOf(rateLimitStrategyWait, rateLimitStrategyFail)},
+				Description: "What to do when Discord rate limits a request, either `wait` for the time Discord asks for and retry, or `fail` straight away.",
+			},
+		},
+	}
+}
+
+func (p *frameworkProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var data frameworkProviderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token := data.Token.ValueString()
+	if token == "" {
+		token = os.Getenv("DISCORD_TOKEN")
+	}
+	if token == "" {
+		resp.Diagnostics.AddError("Missing required token", "The `token` argument or `DISCORD_TOKEN` environment variable must be set")
+		return
+	}
+
+	maxRetries := int64(defaultMaxRetries)
+	if !data.MaxRetries.IsNull() {
+		maxRetries = data.MaxRetries.ValueInt64()
+	}
+	requestTimeout := int64(defaultRequestTimeout)
+	if !data.RequestTimeout.IsNull() {
+		requestTimeout = data.RequestTimeout.ValueInt64()
+	}
+	rateLimitStrategy := rateLimitStrategyWait
+	if !data.RateLimitStrategy.IsNull() {
+		rateLimitStrategy = data.RateLimitStrategy.ValueString()
+	}
+
+	config := Config{
+		Token:             "Bot " + token,
+		ClientID:          data.ClientID.ValueString(),
+		Secret:            data.Secret.ValueString(),
+		BaseURL:           p.baseURL,
+		MaxRetries:        int(maxRetries),
+		RequestTimeout:    time.Duration(requestTimeout) * time.Second,
+		RateLimitStrategy: rateLimitStrategy,
+	}
+
+	client, err := config.Client(p.version)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create the Discord client", err.Error())
+		return
+	}
+
+	resp.ResourceData = client
+	resp.DataSourceData = client
+}
+
+func (p *frameworkProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		resourceDiscordRole,
+		resourceDiscordMessage,
+		resourceDiscordChannel,
+		resourceDiscordCategoryChannel,
+		resourceDiscordTextChannel,
+		resourceDiscordVoiceChannel,
+		resourceDiscordNewsChannel,
+		resourceDiscordForumChannel,
+		resourceDiscordStageChannel,
+		resourceDiscordServer,
+		resourceDiscordManagedServer,
+		resourceDiscordWelcomeScreen,
+		resourceDiscordOnboarding,
+		resourceDiscordServerWidget,
+		resourceDiscordVanityURL,
+		resourceDiscordSystemChannel,
+		resourceDiscordMemberRoles,
+		resourceDiscordMemberNick,
+		resourceDiscordBan,
+		resourceDiscordRoleEveryone,
+		resourceDiscordRoleOrder,
+		resourceDiscordEmoji,
+		resourceDiscordSticker,
+		resourceDiscordInvite,
+		resourceDiscordWebhook,
+		resourceDiscordStageInstance,
+		resourceDiscordChannelPermission,
+		resourceDiscordChannelPermissions,
+		resourceDiscordChannelOrder,
+		resourceDiscordThread,
+		resourceDiscordScheduledEvent,
+		resourceDiscordAutomodRule,
+		resourceDiscordApplicationCommand,
+		resourceDiscordApplicationCommandPermissions,
+	}
+}
+
+func (p *frameworkProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return []func() datasource.DataSource{
+		dataSourceDiscordPermission,
+		dataSourceDiscordColor,
+		dataSourceDiscordLocalImage,
+		dataSourceDiscordRole,
+		dataSourceDiscordServer,
+		dataSourceDiscordChannel,
+		dataSourceDiscordChannels,
+		dataSourceDiscordMember,
+		dataSourceDiscordMembers,
+		dataSourceDiscordBans,
+		dataSourceDiscordSystemChannel,
+		dataSourceDiscordVanityURL,
+		dataSourceDiscordEmojis,
+		dataSourceDiscordSticker,
+	}
+}
+
+// frameworkResource is embedded by the resources built on
+// terraform-plugin-framework, and holds the session the provider configured.
+type frameworkResource struct {
+	client *discordgo.Session
+}
+
+func (r *frameworkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*Context).Session
+}
+
+// frameworkDataSource is the same as frameworkResource, for data sources.
+type frameworkDataSource struct {
+	client *discordgo.Session
+}
+
+func (d *frameworkDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*Context).Session
+}
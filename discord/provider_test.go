@@ -1,25 +1,67 @@
 package discord
 
 import (
+	"context"
+	"net/http"
 	"os"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
 
-// providerFactories are used to instantiate a provider during acceptance testing.
-// The factory function will be invoked for every Terraform CLI command executed
-// to create a provider server to which the CLI can reattach.
-var providerFactories = map[string]func() (*schema.Provider, error){
-	"discord": func() (*schema.Provider, error) {
-		return Provider("dev")(), nil
-	},
+// protoV6ProviderFactories are used to instantiate a provider during acceptance
+// testing. The factory function will be invoked for every Terraform CLI command
+// executed to create a provider server to which the CLI can reattach.
+var protoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"discord": testProviderServer,
 }
 
-func TestProvider(t *testing.T) {
-	if err := Provider("dev")().InternalValidate(); err != nil {
+// fake is the in-process Discord API the tests run against when no
+// DISCORD_TOKEN is provided.
+var fake *fakeDiscord
+
+func TestMain(m *testing.M) {
+	if os.Getenv("DISCORD_TOKEN") == "" {
+		fake = newFakeDiscord()
+		fake.setTestEnv()
+		http.DefaultTransport = fake.transport(http.DefaultTransport)
+	}
+
+	code := m.Run()
+
+	if fake != nil {
+		fake.Close()
+	}
+	os.Exit(code)
+}
+
+// testProviderServer returns the provider server under test, pointed at the
+// fake Discord API when one is running.
+func testProviderServer() (tfprotov6.ProviderServer, error) {
+	p := &frameworkProvider{version: "dev"}
+	if fake != nil {
+		p.baseURL = fake.URL
+	}
+
+	return providerserver.NewProtocol6WithError(p)()
+}
+
+func TestProviderServer(t *testing.T) {
+	res, err := ProviderServer("dev")().GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
 		t.Fatalf("err: %s", err)
 	}
+	for _, d := range res.Diagnostics {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			t.Fatalf("err: %s: %s", d.Summary, d.Detail)
+		}
+	}
+	for _, name := range []string{"discord_server", "discord_role", "discord_message"} {
+		if _, ok := res.ResourceSchemas[name]; !ok {
+			t.Fatalf("%s is not served", name)
+		}
+	}
 }
 
 func testAccPreCheck(t *testing.T) {
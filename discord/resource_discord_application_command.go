@@ -0,0 +1,855 @@
+package discord
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/net/context"
+)
+
+// Options can be nested up to three levels deep: a subcommand group holding
+// subcommands holding options.
+const applicationCommandOptionDepth = 3
+
+// applicationResource is embedded by the resources of application commands,
+// which fall back on the provider's client_id for their application.
+type applicationResource struct {
+	frameworkResource
+	clientId string
+}
+
+func (r *applicationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.frameworkResource.Configure(ctx, req, resp)
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.clientId = req.ProviderData.(*Context).Config.ClientID
+}
+
+// getApplicationId returns applicationId when it's set, falling back on the
+// provider's client_id and then on the application of the bot token.
+func (r *applicationResource) getApplicationId(ctx context.Context, applicationId types.String) (string, error) {
+	if v := applicationId.ValueString(); v != "" {
+		return v, nil
+	}
+	if r.clientId != "" {
+		return r.clientId, nil
+	}
+
+	endpoint := discordgo.EndpointOAuth2Application("@me")
+	body, err := r.client.RequestWithBucketID(http.MethodGet, endpoint, nil, endpoint, discordgo.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+
+	var application discordgo.Application
+	if err := json.Unmarshal(body, &application); err != nil {
+		return "", err
+	}
+
+	return application.ID, nil
+}
+
+type applicationCommandResource struct {
+	applicationResource
+}
+
+type applicationCommandModel struct {
+	ApplicationId            types.String `tfsdk:"application_id"`
+	ServerId                 types.String `tfsdk:"server_id"`
+	Type                     types.String `tfsdk:"type"`
+	Name                     types.String `tfsdk:"name"`
+	NameLocalizations        types.Map    `tfsdk:"name_localizations"`
+	Description              types.String `tfsdk:"description"`
+	DescriptionLocalizations types.Map    `tfsdk:"description_localizations"`
+	DefaultMemberPermissions types.String `tfsdk:"default_member_permissions"`
+	NSFW                     types.Bool   `tfsdk:"nsfw"`
+	Contexts                 types.Set    `tfsdk:"contexts"`
+	Option                   types.List   `tfsdk:"option"`
+	Version                  types.String `tfsdk:"version"`
+	Id                       types.String `tfsdk:"id"`
+}
+
+// applicationCommandOptionModel holds the attributes of an option. The
+// options at the deepest level have no option block, so options are
+// converted from and to objects by hand instead of with tfsdk tags, see
+// getApplicationCommandOptions and applicationCommandOptionsValue.
+type applicationCommandOptionModel struct {
+	Type                     types.String
+	Name                     types.String
+	NameLocalizations        types.Map
+	Description              types.String
+	DescriptionLocalizations types.Map
+	Required                 types.Bool
+	Choice                   []applicationCommandChoiceModel
+	ChannelTypes             types.Set
+	MinValue                 types.String
+	MaxValue                 types.String
+	MinLength                types.Int64
+	MaxLength                types.Int64
+	Autocomplete             types.Bool
+	Option                   types.List
+}
+
+type applicationCommandChoiceModel struct {
+	Name              types.String `tfsdk:"name"`
+	NameLocalizations types.Map    `tfsdk:"name_localizations"`
+	Value             types.String `tfsdk:"value"`
+}
+
+func resourceDiscordApplicationCommand() resource.Resource {
+	return &applicationCommandResource{}
+}
+
+func (r *applicationCommandResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_command"
+}
+
+func (r *applicationCommandResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// Version 1 moved the resource from the SDK to
+		// terraform-plugin-framework.
+		Version:     1,
+		Description: "A resource to register an application command, either globally or in a single server.",
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "ID of the application the command belongs to. Defaults to the provider's `client_id`, or the application of the bot token if that isn't set either.",
+			},
+			"server_id": schema.StringAttribute{
+				Optional:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "ID of the server to register the command in. The command is global when this isn't set.",
+			},
+			"type": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Default:       stringdefault.StaticString("chat_input"),
+				Validators:    []validator.String{stringvalidator.OneOf("chat_input", "user", "message")},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "Type of the command, one of `chat_input` (slash commands), `user` or `message` (context menu commands).",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Validators:  []validator.String{stringvalidator.LengthBetween(1, 32)},
+				Description: "Name of the command.",
+			},
+			"name_localizations": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Localized names of the command, keyed by locale.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.LengthBetween(1, 100)},
+				Description: "Description of the command. Required for `chat_input` commands and not allowed on others.",
+			},
+			"description_localizations": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Localized descriptions of the command, keyed by locale.",
+			},
+			"default_member_permissions": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.RegexMatches(regexp.MustCompile(`^[0-9]+$`), "must be a permission bit set")},
+				Description: "Permission bits a member needs to use the command by default. `0` limits the command to administrators; everyone can use it when this isn't set.",
+			},
+			"nsfw": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the command is age-restricted.",
+			},
+			"contexts": schema.SetAttribute{
+				Optional:      true,
+				Computed:      true,
+				ElementType:   types.StringType,
+				Validators:    []validator.Set{setvalidator.ValueStringsAre(stringvalidator.OneOf("guild", "bot_dm", "private_channel"))},
+				PlanModifiers: []planmodifier.Set{setplanmodifier.UseStateForUnknown()},
+				Description:   "Where the command can be used, any of `guild`, `bot_dm` and `private_channel`.",
+			},
+			"version": schema.StringAttribute{
+				Computed:    true,
+				Description: "Version of the command, updated whenever the command changes.",
+			},
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The ID of the command.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"option": getApplicationCommandOptionBlock(applicationCommandOptionDepth),
+		},
+	}
+}
+
+func getApplicationCommandOptionBlock(depth int) schema.ListNestedBlock {
+	block := schema.ListNestedBlock{
+		Validators:  []validator.List{listvalidator.SizeAtMost(25)},
+		Description: "Options of the command, or of the subcommand (group) this option is nested in.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					Required:    true,
+					Validators:  []validator.String{stringvalidator.OneOf(applicationCommandOptionTypeNames()...)},
+					Description: "Type of the option, one of `sub_command`, `sub_command_group`, `string`, `integer`, `boolean`, `user`, `channel`, `role`, `mentionable`, `number` or `attachment`.",
+				},
+				"name": schema.StringAttribute{
+					Required:    true,
+					Validators:  []validator.String{stringvalidator.LengthBetween(1, 32)},
+					Description: "Name of the option.",
+				},
+				"name_localizations": schema.MapAttribute{
+					Optional:    true,
+					ElementType: types.StringType,
+					Description: "Localized names of the option, keyed by locale.",
+				},
+				"description": schema.StringAttribute{
+					Required:    true,
+					Validators:  []validator.String{stringvalidator.LengthBetween(1, 100)},
+					Description: "Description of the option.",
+				},
+				"description_localizations": schema.MapAttribute{
+					Optional:    true,
+					ElementType: types.StringType,
+					Description: "Localized descriptions of the option, keyed by locale.",
+				},
+				"required": schema.BoolAttribute{
+					Optional:    true,
+					Computed:    true,
+					Default:     booldefault.StaticBool(false),
+					Description: "Whether the option has to be filled in.",
+				},
+				"channel_types": schema.SetAttribute{
+					Optional:    true,
+					ElementType: types.StringType,
+					Validators:  []validator.Set{setvalidator.ValueStringsAre(stringvalidator.OneOf(applicationCommandChannelTypeNames()...))},
+					Description: "Types of the channels that can be picked. Only used by `channel` options.",
+				},
+				"min_value": schema.StringAttribute{
+					Optional:    true,
+					Validators:  []validator.String{isNumber()},
+					Description: "Smallest value allowed. Only used by `integer` and `number` options.",
+				},
+				"max_value": schema.StringAttribute{
+					Optional:    true,
+					Validators:  []validator.String{isNumber()},
+					Description: "Largest value allowed. Only used by `integer` and `number` options.",
+				},
+				"min_length": schema.Int64Attribute{
+					Optional:    true,
+					Validators:  []validator.Int64{int64validator.Between(0, 6000)},
+					Description: "Shortest length allowed. Only used by `string` options.",
+				},
+				"max_length": schema.Int64Attribute{
+					Optional:    true,
+					Validators:  []validator.Int64{int64validator.Between(1, 6000)},
+					Description: "Longest length allowed. Only used by `string` options.",
+				},
+				"autocomplete": schema.BoolAttribute{
+					Optional:    true,
+					Computed:    true,
+					Default:     booldefault.StaticBool(false),
+					Description: "Whether the bot suggests values while the user types. Can't be used together with `choice`.",
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"choice": schema.ListNestedBlock{
+					Validators:  []validator.List{listvalidator.SizeAtMost(25)},
+					Description: "Values the user has to pick from. Only used by `string`, `integer` and `number` options.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"name": schema.StringAttribute{
+								Required:    true,
+								Validators:  []validator.String{stringvalidator.LengthBetween(1, 100)},
+								Description: "Name of the choice.",
+							},
+							"name_localizations": schema.MapAttribute{
+								Optional:    true,
+								ElementType: types.StringType,
+								Description: "Localized names of the choice, keyed by locale.",
+							},
+							"value": schema.StringAttribute{
+								Required:    true,
+								Description: "Value of the choice. It is sent as a number for `integer` and `number` options.",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	if depth > 1 {
+		block.NestedObject.Blocks["option"] = getApplicationCommandOptionBlock(depth - 1)
+	}
+
+	return block
+}
+
+func (r *applicationCommandResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
+
+	return map[int64]resource.StateUpgrader{0: upgradeFromSDK(resp.Schema)}
+}
+
+func (r *applicationCommandResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Global commands are imported as application_id:command_id and server
+	// commands as application_id:server_id:command_id.
+	if applicationId, serverId, commandId, err := parseThreeIds(req.ID); err == nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), commandId)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("application_id"), applicationId)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), serverId)...)
+	} else if applicationId, commandId, err := parseTwoIds(req.ID); err == nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), commandId)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("application_id"), applicationId)...)
+	} else {
+		resp.Diagnostics.AddError("Failed to import application command", err.Error())
+	}
+}
+
+// applicationCommand mirrors Discord's application command object. discordgo's
+// version has no contexts and can't send a max_value of zero.
+type applicationCommand struct {
+	ID                       string                      `json:"id,omitempty"`
+	ApplicationID            string                      `json:"application_id,omitempty"`
+	GuildID                  string                      `json:"guild_id,omitempty"`
+	Version                  string                      `json:"version,omitempty"`
+	Type                     int                         `json:"type"`
+	Name                     string                      `json:"name"`
+	NameLocalizations        map[string]string           `json:"name_localizations"`
+	Description              string                      `json:"description"`
+	DescriptionLocalizations map[string]string           `json:"description_localizations"`
+	Options                  []*applicationCommandOption `json:"options"`
+	DefaultMemberPermissions *string                     `json:"default_member_permissions"`
+	NSFW                     bool                        `json:"nsfw"`
+	Contexts                 []int                       `json:"contexts,omitempty"`
+}
+
+type applicationCommandOption struct {
+	Type                     int                               `json:"type"`
+	Name                     string                            `json:"name"`
+	NameLocalizations        map[string]string                 `json:"name_localizations,omitempty"`
+	Description              string                            `json:"description"`
+	DescriptionLocalizations map[string]string                 `json:"description_localizations,omitempty"`
+	Required                 bool                              `json:"required,omitempty"`
+	Choices                  []*applicationCommandOptionChoice `json:"choices,omitempty"`
+	Options                  []*applicationCommandOption       `json:"options,omitempty"`
+	ChannelTypes             []int                             `json:"channel_types,omitempty"`
+	MinValue                 *float64                          `json:"min_value,omitempty"`
+	MaxValue                 *float64                          `json:"max_value,omitempty"`
+	MinLength                *int                              `json:"min_length,omitempty"`
+	MaxLength                *int                              `json:"max_length,omitempty"`
+	Autocomplete             bool                              `json:"autocomplete,omitempty"`
+}
+
+type applicationCommandOptionChoice struct {
+	Name              string            `json:"name"`
+	NameLocalizations map[string]string `json:"name_localizations,omitempty"`
+	Value             interface{}       `json:"value"`
+}
+
+var applicationCommandTypes = map[string]int{
+	"chat_input": 1,
+	"user":       2,
+	"message":    3,
+}
+
+var applicationCommandOptionTypes = map[string]int{
+	"sub_command":       1,
+	"sub_command_group": 2,
+	"string":            3,
+	"integer":           4,
+	"boolean":           5,
+	"user":              6,
+	"channel":           7,
+	"role":              8,
+	"mentionable":       9,
+	"number":            10,
+	"attachment":        11,
+}
+
+var applicationCommandContexts = map[string]int{
+	"guild":           0,
+	"bot_dm":          1,
+	"private_channel": 2,
+}
+
+var applicationCommandChannelTypes = map[string]discordgo.ChannelType{
+	"text":           discordgo.ChannelTypeGuildText,
+	"voice":          discordgo.ChannelTypeGuildVoice,
+	"category":       discordgo.ChannelTypeGuildCategory,
+	"news":           discordgo.ChannelTypeGuildNews,
+	"news_thread":    discordgo.ChannelTypeGuildNewsThread,
+	"public_thread":  discordgo.ChannelTypeGuildPublicThread,
+	"private_thread": discordgo.ChannelTypeGuildPrivateThread,
+	"stage":          discordgo.ChannelTypeGuildStageVoice,
+	"forum":          discordgo.ChannelTypeGuildForum,
+	"media":          discordgo.ChannelTypeGuildMedia,
+}
+
+func applicationCommandOptionTypeNames() []string {
+	res := make([]string, 0, len(applicationCommandOptionTypes))
+	for k := range applicationCommandOptionTypes {
+		res = append(res, k)
+	}
+
+	return res
+}
+
+func applicationCommandChannelTypeNames() []string {
+	res := make([]string, 0, len(applicationCommandChannelTypes))
+	for k := range applicationCommandChannelTypes {
+		res = append(res, k)
+	}
+
+	return res
+}
+
+func getApplicationCommandEndpoint(applicationId string, serverId types.String, commandId string) string {
+	if !serverId.IsNull() {
+		if commandId == "" {
+			return discordgo.EndpointApplicationGuildCommands(applicationId, serverId.ValueString())
+		}
+		return discordgo.EndpointApplicationGuildCommand(applicationId, serverId.ValueString(), commandId)
+	}
+
+	if commandId == "" {
+		return discordgo.EndpointApplicationGlobalCommands(applicationId)
+	}
+	return discordgo.EndpointApplicationGlobalCommand(applicationId, commandId)
+}
+
+// getApplicationCommandOptions returns the options in list, which is a list
+// of option objects at any depth.
+func getApplicationCommandOptions(ctx context.Context, list types.List) ([]applicationCommandOptionModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	res := make([]applicationCommandOptionModel, 0, len(list.Elements()))
+	for _, e := range list.Elements() {
+		attrs := e.(types.Object).Attributes()
+		option := applicationCommandOptionModel{
+			Type:                     attrs["type"].(types.String),
+			Name:                     attrs["name"].(types.String),
+			NameLocalizations:        attrs["name_localizations"].(types.Map),
+			Description:              attrs["description"].(types.String),
+			DescriptionLocalizations: attrs["description_localizations"].(types.Map),
+			Required:                 attrs["required"].(types.Bool),
+			ChannelTypes:             attrs["channel_types"].(types.Set),
+			MinValue:                 attrs["min_value"].(types.String),
+			MaxValue:                 attrs["max_value"].(types.String),
+			MinLength:                attrs["min_length"].(types.Int64),
+			MaxLength:                attrs["max_length"].(types.Int64),
+			Autocomplete:             attrs["autocomplete"].(types.Bool),
+		}
+		diags.Append(attrs["choice"].(types.List).ElementsAs(ctx, &option.Choice, false)...)
+		if v, ok := attrs["option"]; ok {
+			option.Option = v.(types.List)
+		}
+		res = append(res, option)
+	}
+
+	return res, diags
+}
+
+// applicationCommandOptionsValue returns options as a list of option objects
+// at depth.
+func applicationCommandOptionsValue(ctx context.Context, options []applicationCommandOptionModel, depth int) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	optionType := getApplicationCommandOptionBlock(depth).NestedObject.Type().(types.ObjectType)
+	choiceType := optionType.AttrTypes["choice"].(types.ListType).ElemType
+
+	values := make([]attr.Value, 0, len(options))
+	for _, o := range options {
+		choice, d := types.ListValueFrom(ctx, choiceType, o.Choice)
+		diags.Append(d...)
+		attrs := map[string]attr.Value{
+			"type":                      o.Type,
+			"name":                      o.Name,
+			"name_localizations":        o.NameLocalizations,
+			"description":               o.Description,
+			"description_localizations": o.DescriptionLocalizations,
+			"required":                  o.Required,
+			"choice":                    choice,
+			"channel_types":             o.ChannelTypes,
+			"min_value":                 o.MinValue,
+			"max_value":                 o.MaxValue,
+			"min_length":                o.MinLength,
+			"max_length":                o.MaxLength,
+			"autocomplete":              o.Autocomplete,
+		}
+		if depth > 1 {
+			attrs["option"] = o.Option
+		}
+
+		value, d := types.ObjectValue(optionType.AttrTypes, attrs)
+		diags.Append(d...)
+		values = append(values, value)
+	}
+
+	list, d := types.ListValue(optionType, values)
+	diags.Append(d...)
+
+	return list, diags
+}
+
+func buildApplicationCommandOptions(ctx context.Context, list types.List) ([]*applicationCommandOption, error) {
+	options, _ := getApplicationCommandOptions(ctx, list)
+
+	res := make([]*applicationCommandOption, 0, len(options))
+	for _, option := range options {
+		optionType := applicationCommandOptionTypes[option.Type.ValueString()]
+
+		opt := &applicationCommandOption{
+			Type:                     optionType,
+			Name:                     option.Name.ValueString(),
+			NameLocalizations:        mapStrings(ctx, option.NameLocalizations),
+			Description:              option.Description.ValueString(),
+			DescriptionLocalizations: mapStrings(ctx, option.DescriptionLocalizations),
+			Required:                 option.Required.ValueBool(),
+			Autocomplete:             option.Autocomplete.ValueBool(),
+		}
+
+		for _, choice := range option.Choice {
+			var value interface{} = choice.Value.ValueString()
+			if optionType == applicationCommandOptionTypes["integer"] || optionType == applicationCommandOptionTypes["number"] {
+				number, err := strconv.ParseFloat(choice.Value.ValueString(), 64)
+				if err != nil {
+					return nil, fmt.Errorf("choice %s of option %s must be a number", choice.Name.ValueString(), opt.Name)
+				}
+				value = number
+			}
+			opt.Choices = append(opt.Choices, &applicationCommandOptionChoice{
+				Name:              choice.Name.ValueString(),
+				NameLocalizations: mapStrings(ctx, choice.NameLocalizations),
+				Value:             value,
+			})
+		}
+
+		channelTypes, _ := setStrings(ctx, option.ChannelTypes)
+		for _, t := range channelTypes {
+			opt.ChannelTypes = append(opt.ChannelTypes, int(applicationCommandChannelTypes[t]))
+		}
+
+		if v := option.MinValue.ValueString(); v != "" {
+			minValue, _ := strconv.ParseFloat(v, 64)
+			opt.MinValue = &minValue
+		}
+		if v := option.MaxValue.ValueString(); v != "" {
+			maxValue, _ := strconv.ParseFloat(v, 64)
+			opt.MaxValue = &maxValue
+		}
+		if v := int(option.MinLength.ValueInt64()); v > 0 {
+			opt.MinLength = &v
+		}
+		if v := int(option.MaxLength.ValueInt64()); v > 0 {
+			opt.MaxLength = &v
+		}
+
+		if !option.Option.IsNull() {
+			options, err := buildApplicationCommandOptions(ctx, option.Option)
+			if err != nil {
+				return nil, err
+			}
+			opt.Options = options
+		}
+
+		res = append(res, opt)
+	}
+
+	return res, nil
+}
+
+// unbuildApplicationCommandChoiceValue returns the value of a choice the way
+// it's configured. Numbers come back as float64, which fmt.Sprint would print
+// in exponent form from 1e+06 on.
+func unbuildApplicationCommandChoiceValue(value interface{}) string {
+	if v, ok := value.(float64); ok {
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+
+	return fmt.Sprint(value)
+}
+
+// unbuildApplicationCommandOptions returns options as a list of option
+// objects at depth, keeping the optional values that Discord leaves empty null
+// while they're null in prior.
+func unbuildApplicationCommandOptions(ctx context.Context, options []*applicationCommandOption, prior types.List, depth int) (types.List, diag.Diagnostics) {
+	var diags, d diag.Diagnostics
+
+	priorOptions, d := getApplicationCommandOptions(ctx, prior)
+	diags.Append(d...)
+
+	res := make([]applicationCommandOptionModel, 0, len(options))
+	for i, opt := range options {
+		var p applicationCommandOptionModel
+		if i < len(priorOptions) {
+			p = priorOptions[i]
+		}
+
+		option := applicationCommandOptionModel{
+			Type:         types.StringValue(getTextValue(applicationCommandOptionTypes, opt.Type)),
+			Name:         types.StringValue(opt.Name),
+			Description:  types.StringValue(opt.Description),
+			Required:     types.BoolValue(opt.Required),
+			Autocomplete: types.BoolValue(opt.Autocomplete),
+			MinValue:     types.StringNull(),
+			MaxValue:     types.StringNull(),
+			MinLength:    int64Value(0, p.MinLength),
+			MaxLength:    int64Value(0, p.MaxLength),
+			Choice:       make([]applicationCommandChoiceModel, 0, len(opt.Choices)),
+		}
+		option.NameLocalizations, d = mapValue(ctx, opt.NameLocalizations, p.NameLocalizations)
+		diags.Append(d...)
+		option.DescriptionLocalizations, d = mapValue(ctx, opt.DescriptionLocalizations, p.DescriptionLocalizations)
+		diags.Append(d...)
+
+		for j, c := range opt.Choices {
+			var priorChoice applicationCommandChoiceModel
+			if j < len(p.Choice) {
+				priorChoice = p.Choice[j]
+			}
+
+			choice := applicationCommandChoiceModel{
+				Name:  types.StringValue(c.Name),
+				Value: types.StringValue(unbuildApplicationCommandChoiceValue(c.Value)),
+			}
+			choice.NameLocalizations, d = mapValue(ctx, c.NameLocalizations, priorChoice.NameLocalizations)
+			diags.Append(d...)
+			option.Choice = append(option.Choice, choice)
+		}
+
+		channelTypes := make([]string, 0, len(opt.ChannelTypes))
+		for _, t := range opt.ChannelTypes {
+			for k, v := range applicationCommandChannelTypes {
+				if int(v) == t {
+					channelTypes = append(channelTypes, k)
+				}
+			}
+		}
+		option.ChannelTypes, d = setValue(ctx, channelTypes, p.ChannelTypes)
+		diags.Append(d...)
+
+		if opt.MinValue != nil {
+			option.MinValue = types.StringValue(strconv.FormatFloat(*opt.MinValue, 'f', -1, 64))
+		}
+		if opt.MaxValue != nil {
+			option.MaxValue = types.StringValue(strconv.FormatFloat(*opt.MaxValue, 'f', -1, 64))
+		}
+		if opt.MinLength != nil {
+			option.MinLength = types.Int64Value(int64(*opt.MinLength))
+		}
+		if opt.MaxLength != nil {
+			option.MaxLength = types.Int64Value(int64(*opt.MaxLength))
+		}
+
+		if depth > 1 {
+			option.Option, d = unbuildApplicationCommandOptions(ctx, opt.Options, p.Option, depth-1)
+			diags.Append(d...)
+		}
+
+		res = append(res, option)
+	}
+
+	list, d := applicationCommandOptionsValue(ctx, res, depth)
+	diags.Append(d...)
+
+	return list, diags
+}
+
+func buildApplicationCommand(ctx context.Context, plan applicationCommandModel) (*applicationCommand, error) {
+	options, err := buildApplicationCommandOptions(ctx, plan.Option)
+	if err != nil {
+		return nil, err
+	}
+
+	command := &applicationCommand{
+		Type:                     applicationCommandTypes[plan.Type.ValueString()],
+		Name:                     plan.Name.ValueString(),
+		NameLocalizations:        mapStrings(ctx, plan.NameLocalizations),
+		Description:              plan.Description.ValueString(),
+		DescriptionLocalizations: mapStrings(ctx, plan.DescriptionLocalizations),
+		Options:                  options,
+		NSFW:                     plan.NSFW.ValueBool(),
+	}
+	if !plan.DefaultMemberPermissions.IsNull() {
+		command.DefaultMemberPermissions = plan.DefaultMemberPermissions.ValueStringPointer()
+	}
+	contexts, _ := setStrings(ctx, plan.Contexts)
+	for _, c := range contexts {
+		command.Contexts = append(command.Contexts, applicationCommandContexts[c])
+	}
+
+	return command, nil
+}
+
+// applicationCommandContextsValue returns the contexts of command as a set.
+func applicationCommandContextsValue(ctx context.Context, command *applicationCommand) (types.Set, diag.Diagnostics) {
+	contexts := make([]string, 0, len(command.Contexts))
+	for _, c := range command.Contexts {
+		contexts = append(contexts, getTextValue(applicationCommandContexts, c))
+	}
+
+	return setOfStrings(ctx, contexts)
+}
+
+func requestApplicationCommand(ctx context.Context, client *discordgo.Session, method string, endpoint string, command *applicationCommand) (*applicationCommand, error) {
+	var data interface{}
+	if command != nil {
+		data = command
+	}
+
+	body, err := client.RequestWithBucketID(method, endpoint, data, endpoint, discordgo.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	var res applicationCommand
+	if err := json.Unmarshal(body, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (r *applicationCommandResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan applicationCommandModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applicationId, err := r.getApplicationId(ctx, plan.ApplicationId)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to fetch application", err.Error())
+		return
+	}
+	plan.ApplicationId = types.StringValue(applicationId)
+
+	command, err := buildApplicationCommand(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to create application command %s", plan.Name.ValueString()), err.Error())
+		return
+	}
+
+	command, err = requestApplicationCommand(ctx, r.client, http.MethodPost, getApplicationCommandEndpoint(applicationId, plan.ServerId, ""), command)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to create application command %s", plan.Name.ValueString()), err.Error())
+		return
+	}
+
+	plan.Id = types.StringValue(command.ID)
+	plan.Version = types.StringValue(command.Version)
+	if plan.Contexts.IsUnknown() {
+		var diags diag.Diagnostics
+		plan.Contexts, diags = applicationCommandContextsValue(ctx, command)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *applicationCommandResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state applicationCommandModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	commandId := state.Id.ValueString()
+	command, err := requestApplicationCommand(ctx, r.client, http.MethodGet, getApplicationCommandEndpoint(state.ApplicationId.ValueString(), state.ServerId, commandId), nil)
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to fetch application command %s", commandId), err.Error())
+		return
+	}
+
+	var diags diag.Diagnostics
+	state.ApplicationId = types.StringValue(command.ApplicationID)
+	state.Type = types.StringValue(getTextValue(applicationCommandTypes, command.Type))
+	state.Name = types.StringValue(command.Name)
+	state.NameLocalizations, diags = mapValue(ctx, command.NameLocalizations, state.NameLocalizations)
+	resp.Diagnostics.Append(diags...)
+	state.Description = stringValue(command.Description, state.Description)
+	state.DescriptionLocalizations, diags = mapValue(ctx, command.DescriptionLocalizations, state.DescriptionLocalizations)
+	resp.Diagnostics.Append(diags...)
+	state.NSFW = types.BoolValue(command.NSFW)
+	state.Version = types.StringValue(command.Version)
+	state.Option, diags = unbuildApplicationCommandOptions(ctx, command.Options, state.Option, applicationCommandOptionDepth)
+	resp.Diagnostics.Append(diags...)
+	state.DefaultMemberPermissions = types.StringPointerValue(command.DefaultMemberPermissions)
+	state.Contexts, diags = applicationCommandContextsValue(ctx, command)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *applicationCommandResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan applicationCommandModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	commandId := plan.Id.ValueString()
+	command, err := buildApplicationCommand(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to update application command %s", commandId), err.Error())
+		return
+	}
+
+	command, err = requestApplicationCommand(ctx, r.client, http.MethodPatch, getApplicationCommandEndpoint(plan.ApplicationId.ValueString(), plan.ServerId, commandId), command)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to update application command %s", commandId), err.Error())
+		return
+	}
+
+	plan.Version = types.StringValue(command.Version)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *applicationCommandResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state applicationCommandModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	commandId := state.Id.ValueString()
+	endpoint := getApplicationCommandEndpoint(state.ApplicationId.ValueString(), state.ServerId, commandId)
+	if _, err := r.client.RequestWithBucketID(http.MethodDelete, endpoint, nil, endpoint, discordgo.WithContext(ctx)); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to delete application command %s", commandId), err.Error())
+	}
+}
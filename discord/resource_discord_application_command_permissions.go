@@ -0,0 +1,245 @@
+package discord
+
+import (
+	"fmt"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/net/context"
+)
+
+type applicationCommandPermissionsResource struct {
+	applicationResource
+}
+
+type applicationCommandPermissionsModel struct {
+	ApplicationId types.String                        `tfsdk:"application_id"`
+	ServerId      types.String                        `tfsdk:"server_id"`
+	CommandId     types.String                        `tfsdk:"command_id"`
+	BearerToken   types.String                        `tfsdk:"bearer_token"`
+	Permission    []applicationCommandPermissionModel `tfsdk:"permission"`
+	Id            types.String                        `tfsdk:"id"`
+}
+
+type applicationCommandPermissionModel struct {
+	Type       types.String `tfsdk:"type"`
+	Id         types.String `tfsdk:"id"`
+	Permission types.Bool   `tfsdk:"permission"`
+}
+
+func resourceDiscordApplicationCommandPermissions() resource.Resource {
+	return &applicationCommandPermissionsResource{}
+}
+
+func (r *applicationCommandPermissionsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_command_permissions"
+}
+
+func (r *applicationCommandPermissionsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// Version 1 moved the resource from the SDK to
+		// terraform-plugin-framework.
+		Version:     1,
+		Description: "A resource to manage who can use an application command in a server. This replaces every permission of the command in the server.",
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "ID of the application the command belongs to. Defaults to the provider's `client_id`, or the application of the bot token if that isn't set either.",
+			},
+			"server_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "ID of the server the permissions apply in.",
+			},
+			"command_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "ID of the command. Use the application ID to set the default permissions of all the application's commands.",
+			},
+			"bearer_token": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "OAuth2 bearer token of a server admin with the `applications.commands.permissions.update` scope. Discord doesn't let bots edit command permissions, so the provider's token can't be used.",
+			},
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The ID of the permissions, in the format `server_id:command_id`.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"permission": schema.SetNestedBlock{
+				Validators:  []validator.Set{setvalidator.SizeAtMost(100)},
+				Description: "Permissions of the command.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Required:    true,
+							Validators:  []validator.String{stringvalidator.OneOf("role", "user", "channel")},
+							Description: "Type of the target, one of `role`, `user` or `channel`.",
+						},
+						"id": schema.StringAttribute{
+							Required:    true,
+							Description: "ID of the target. Use the server ID for `@everyone`, or the server ID minus one for all channels.",
+						},
+						"permission": schema.BoolAttribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(true),
+							Description: "Whether the target is allowed to use the command.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *applicationCommandPermissionsResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
+
+	return map[int64]resource.StateUpgrader{0: upgradeFromSDK(resp.Schema)}
+}
+
+func (r *applicationCommandPermissionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	applicationId, serverId, commandId, err := parseThreeIds(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import application command permissions", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), generateTwoPartId(serverId, commandId))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("application_id"), applicationId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), serverId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("command_id"), commandId)...)
+}
+
+var applicationCommandPermissionTypes = map[string]int{
+	"role":    int(discordgo.ApplicationCommandPermissionTypeRole),
+	"user":    int(discordgo.ApplicationCommandPermissionTypeUser),
+	"channel": int(discordgo.ApplicationCommandPermissionTypeChannel),
+}
+
+func buildApplicationCommandPermissions(plan applicationCommandPermissionsModel) *discordgo.ApplicationCommandPermissionsList {
+	permissions := make([]*discordgo.ApplicationCommandPermissions, 0, len(plan.Permission))
+	for _, p := range plan.Permission {
+		permissions = append(permissions, &discordgo.ApplicationCommandPermissions{
+			ID:         p.Id.ValueString(),
+			Type:       discordgo.ApplicationCommandPermissionType(applicationCommandPermissionTypes[p.Type.ValueString()]),
+			Permission: p.Permission.ValueBool(),
+		})
+	}
+
+	return &discordgo.ApplicationCommandPermissionsList{Permissions: permissions}
+}
+
+func (r *applicationCommandPermissionsResource) editPermissions(ctx context.Context, m applicationCommandPermissionsModel, permissions *discordgo.ApplicationCommandPermissionsList) error {
+	return r.client.ApplicationCommandPermissionsEdit(
+		m.ApplicationId.ValueString(),
+		m.ServerId.ValueString(),
+		m.CommandId.ValueString(),
+		permissions,
+		discordgo.WithContext(ctx),
+		discordgo.WithHeader("Authorization", "Bearer "+m.BearerToken.ValueString()),
+	)
+}
+
+func (r *applicationCommandPermissionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan applicationCommandPermissionsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applicationId, err := r.getApplicationId(ctx, plan.ApplicationId)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to fetch application", err.Error())
+		return
+	}
+	plan.ApplicationId = types.StringValue(applicationId)
+
+	serverId := plan.ServerId.ValueString()
+	commandId := plan.CommandId.ValueString()
+	if err := r.editPermissions(ctx, plan, buildApplicationCommandPermissions(plan)); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to set permissions of application command %s in %s", commandId, serverId), err.Error())
+		return
+	}
+
+	plan.Id = types.StringValue(generateTwoPartId(serverId, commandId))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *applicationCommandPermissionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state applicationCommandPermissionsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverId := state.ServerId.ValueString()
+	commandId := state.CommandId.ValueString()
+	state.Permission = make([]applicationCommandPermissionModel, 0)
+
+	res, err := r.client.ApplicationCommandPermissions(state.ApplicationId.ValueString(), serverId, commandId, discordgo.WithContext(ctx))
+	if err != nil {
+		// Commands without any permissions in a server have none to fetch.
+		if getErrorCode(err) != discordgo.ErrCodeUnknownApplicationCommandPermissions {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to fetch permissions of application command %s in %s", commandId, serverId), err.Error())
+			return
+		}
+	} else {
+		for _, p := range res.Permissions {
+			state.Permission = append(state.Permission, applicationCommandPermissionModel{
+				Type:       types.StringValue(getTextValue(applicationCommandPermissionTypes, int(p.Type))),
+				Id:         types.StringValue(p.ID),
+				Permission: types.BoolValue(p.Permission),
+			})
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *applicationCommandPermissionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan applicationCommandPermissionsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.editPermissions(ctx, plan, buildApplicationCommandPermissions(plan)); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to update permissions of application command %s", plan.CommandId.ValueString()), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *applicationCommandPermissionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state applicationCommandPermissionsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.editPermissions(ctx, state, &discordgo.ApplicationCommandPermissionsList{
+		Permissions: []*discordgo.ApplicationCommandPermissions{},
+	}); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to remove permissions of application command %s", state.CommandId.ValueString()), err.Error())
+	}
+}
@@ -0,0 +1,68 @@
+package discord
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceDiscordApplicationCommandPermissions(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	testRoleID := os.Getenv("DISCORD_TEST_ROLE_ID")
+	testBearerToken := os.Getenv("DISCORD_TEST_BEARER_TOKEN")
+	if testServerID == "" || testRoleID == "" || testBearerToken == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID, DISCORD_TEST_ROLE_ID and DISCORD_TEST_BEARER_TOKEN envvars must be set for acceptance tests")
+	}
+	name := "discord_application_command_permissions.example"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDiscordApplicationCommandPermissions(testServerID, testRoleID, testBearerToken),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "server_id", testServerID),
+					resource.TestCheckResourceAttrPair(name, "command_id", "discord_application_command.example", "id"),
+					resource.TestCheckResourceAttr(name, "permission.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "permission.*", map[string]string{
+						"type":       "role",
+						"id":         testRoleID,
+						"permission": "true",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "permission.*", map[string]string{
+						"type":       "role",
+						"id":         testServerID,
+						"permission": "false",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceDiscordApplicationCommandPermissions(serverID string, roleID string, bearerToken string) string {
+	return fmt.Sprintf(`
+	resource "discord_application_command" "example" {
+	  server_id = "%[1]s"
+      name = "terraform-moderate"
+      description = "Moderation tools"
+	}
+
+	resource "discord_application_command_permissions" "example" {
+	  server_id = "%[1]s"
+      command_id = discord_application_command.example.id
+      bearer_token = "%[3]s"
+
+      permission {
+        type = "role"
+        id = "%[2]s"
+      }
+      permission {
+        type = "role"
+        id = "%[1]s"
+        permission = false
+      }
+	}`, serverID, roleID, bearerToken)
+}
@@ -0,0 +1,90 @@
+package discord
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceDiscordApplicationCommand(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	if testServerID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID envvar must be set for acceptance tests")
+	}
+	name := "discord_application_command.example"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDiscordApplicationCommand(testServerID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "server_id", testServerID),
+					resource.TestCheckResourceAttrSet(name, "application_id"),
+					resource.TestCheckResourceAttr(name, "type", "chat_input"),
+					resource.TestCheckResourceAttr(name, "name", "terraform-remind"),
+					resource.TestCheckResourceAttr(name, "name_localizations.de", "terraform-erinnern"),
+					resource.TestCheckResourceAttr(name, "default_member_permissions", "0"),
+					resource.TestCheckResourceAttr(name, "option.#", "1"),
+					resource.TestCheckResourceAttr(name, "option.0.type", "sub_command"),
+					resource.TestCheckResourceAttr(name, "option.0.option.#", "3"),
+					resource.TestCheckResourceAttr(name, "option.0.option.0.min_value", "0"),
+					resource.TestCheckResourceAttr(name, "option.0.option.0.max_value", "60"),
+					resource.TestCheckResourceAttr(name, "option.0.option.1.choice.#", "2"),
+					resource.TestCheckResourceAttr(name, "option.0.option.1.choice.1.value", "hours"),
+					resource.TestCheckResourceAttr(name, "option.0.option.2.channel_types.#", "1"),
+					resource.TestCheckResourceAttrSet(name, "version"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceDiscordApplicationCommand(serverID string) string {
+	return fmt.Sprintf(`
+	resource "discord_application_command" "example" {
+	  server_id = "%[1]s"
+      name = "terraform-remind"
+      description = "Set a reminder"
+      default_member_permissions = "0"
+      name_localizations = {
+        de = "terraform-erinnern"
+      }
+
+      option {
+        type = "sub_command"
+        name = "in"
+        description = "Remind after a while"
+
+        option {
+          type = "integer"
+          name = "amount"
+          description = "How long to wait"
+          required = true
+          min_value = 0
+          max_value = 60
+        }
+        option {
+          type = "string"
+          name = "unit"
+          description = "Unit of the amount"
+          choice {
+            name = "Minutes"
+            value = "minutes"
+          }
+          choice {
+            name = "Hours"
+            value = "hours"
+          }
+        }
+        option {
+          type = "channel"
+          name = "channel"
+          description = "Where to send the reminder"
+          channel_types = ["text"]
+        }
+      }
+	}`, serverID)
+}
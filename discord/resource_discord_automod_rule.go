@@ -0,0 +1,516 @@
+package discord
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/net/context"
+)
+
+type automodRuleResource struct {
+	frameworkResource
+}
+
+type automodRuleModel struct {
+	ServerId        types.String                  `tfsdk:"server_id"`
+	Name            types.String                  `tfsdk:"name"`
+	TriggerType     types.String                  `tfsdk:"trigger_type"`
+	TriggerMetadata []automodTriggerMetadataModel `tfsdk:"trigger_metadata"`
+	Action          []automodActionModel          `tfsdk:"action"`
+	Enabled         types.Bool                    `tfsdk:"enabled"`
+	ExemptRoles     types.Set                     `tfsdk:"exempt_roles"`
+	ExemptChannels  types.Set                     `tfsdk:"exempt_channels"`
+	CreatorId       types.String                  `tfsdk:"creator_id"`
+	Id              types.String                  `tfsdk:"id"`
+}
+
+type automodTriggerMetadataModel struct {
+	KeywordFilter                types.Set   `tfsdk:"keyword_filter"`
+	RegexPatterns                types.Set   `tfsdk:"regex_patterns"`
+	Presets                      types.Set   `tfsdk:"presets"`
+	AllowList                    types.Set   `tfsdk:"allow_list"`
+	MentionTotalLimit            types.Int64 `tfsdk:"mention_total_limit"`
+	MentionRaidProtectionEnabled types.Bool  `tfsdk:"mention_raid_protection_enabled"`
+}
+
+type automodActionModel struct {
+	Type            types.String `tfsdk:"type"`
+	CustomMessage   types.String `tfsdk:"custom_message"`
+	ChannelId       types.String `tfsdk:"channel_id"`
+	DurationSeconds types.Int64  `tfsdk:"duration_seconds"`
+}
+
+func resourceDiscordAutomodRule() resource.Resource {
+	return &automodRuleResource{}
+}
+
+func (r *automodRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_automod_rule"
+}
+
+func (r *automodRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// Version 1 moved the resource from the SDK to
+		// terraform-plugin-framework.
+		Version:     1,
+		Description: "A resource to create an AutoMod rule for a server.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "ID of the server the rule is in.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the rule.",
+			},
+			"trigger_type": schema.StringAttribute{
+				Required:      true,
+				Validators:    []validator.String{stringvalidator.OneOf("keyword", "spam", "keyword_preset", "mention_spam", "member_profile")},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "What triggers the rule, one of `keyword`, `spam`, `keyword_preset`, `mention_spam` or `member_profile`.",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the rule is enabled.",
+			},
+			"exempt_roles": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the roles the rule doesn't apply to.",
+			},
+			"exempt_channels": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the channels the rule doesn't apply to.",
+			},
+			"creator_id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "ID of the user who created the rule.",
+			},
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The ID of the rule.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"trigger_metadata": schema.ListNestedBlock{
+				Validators:  []validator.List{listvalidator.SizeAtMost(1)},
+				Description: "Additional data used to decide whether the rule is triggered. Which fields apply depends on `trigger_type`.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"keyword_filter": schema.SetAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "Words that trigger the rule. Used by `keyword` and `member_profile` rules.",
+						},
+						"regex_patterns": schema.SetAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "Regular expressions that trigger the rule. Used by `keyword` and `member_profile` rules.",
+						},
+						"presets": schema.SetAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Validators:  []validator.Set{setvalidator.ValueStringsAre(stringvalidator.OneOf("profanity", "sexual_content", "slurs"))},
+							Description: "Word lists defined by Discord that trigger the rule, any of `profanity`, `sexual_content` and `slurs`. Used by `keyword_preset` rules.",
+						},
+						"allow_list": schema.SetAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "Words that never trigger the rule. Used by `keyword`, `keyword_preset` and `member_profile` rules.",
+						},
+						"mention_total_limit": schema.Int64Attribute{
+							Optional:    true,
+							Validators:  []validator.Int64{int64validator.Between(0, 50)},
+							Description: "Number of unique role and user mentions allowed per message. Used by `mention_spam` rules.",
+						},
+						"mention_raid_protection_enabled": schema.BoolAttribute{
+							Optional:    true,
+							Description: "Whether mention raids are detected automatically. Used by `mention_spam` rules.",
+						},
+					},
+				},
+			},
+			"action": schema.ListNestedBlock{
+				Validators:  []validator.List{listvalidator.IsRequired(), listvalidator.SizeAtLeast(1)},
+				Description: "Actions taken when the rule is triggered.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Required:    true,
+							Validators:  []validator.String{stringvalidator.OneOf("block_message", "send_alert_message", "timeout")},
+							Description: "Type of the action, one of `block_message`, `send_alert_message` or `timeout`.",
+						},
+						"custom_message": schema.StringAttribute{
+							Optional:    true,
+							Validators:  []validator.String{stringvalidator.LengthAtMost(150)},
+							Description: "Message shown to the member whose message was blocked. Used by `block_message` actions.",
+						},
+						"channel_id": schema.StringAttribute{
+							Optional:    true,
+							Description: "ID of the channel alerts are sent to. Used by `send_alert_message` actions.",
+						},
+						"duration_seconds": schema.Int64Attribute{
+							Optional:    true,
+							Validators:  []validator.Int64{int64validator.Between(0, 2419200)},
+							Description: "How long the member is timed out for in seconds. Used by `timeout` actions.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *automodRuleResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
+
+	return map[int64]resource.StateUpgrader{0: upgradeFromSDK(resp.Schema)}
+}
+
+func (r *automodRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	serverId, ruleId, err := parseTwoIds(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import AutoMod rule", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), ruleId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), serverId)...)
+}
+
+// automodRule mirrors Discord's auto moderation rule object. discordgo's
+// version is missing the newer trigger types and action metadata.
+type automodRule struct {
+	ID              string                  `json:"id,omitempty"`
+	GuildID         string                  `json:"guild_id,omitempty"`
+	Name            string                  `json:"name"`
+	CreatorID       string                  `json:"creator_id,omitempty"`
+	EventType       int                     `json:"event_type"`
+	TriggerType     int                     `json:"trigger_type"`
+	TriggerMetadata *automodTriggerMetadata `json:"trigger_metadata,omitempty"`
+	Actions         []automodAction         `json:"actions"`
+	Enabled         bool                    `json:"enabled"`
+	ExemptRoles     []string                `json:"exempt_roles"`
+	ExemptChannels  []string                `json:"exempt_channels"`
+}
+
+// automodTriggerMetadata only has the fields of the trigger type set, which
+// are sent even when they're empty, so they can be cleared.
+type automodTriggerMetadata struct {
+	KeywordFilter                *[]string `json:"keyword_filter,omitempty"`
+	RegexPatterns                *[]string `json:"regex_patterns,omitempty"`
+	Presets                      *[]int    `json:"presets,omitempty"`
+	AllowList                    *[]string `json:"allow_list,omitempty"`
+	MentionTotalLimit            *int      `json:"mention_total_limit,omitempty"`
+	MentionRaidProtectionEnabled *bool     `json:"mention_raid_protection_enabled,omitempty"`
+}
+
+type automodAction struct {
+	Type     int                    `json:"type"`
+	Metadata *automodActionMetadata `json:"metadata,omitempty"`
+}
+
+type automodActionMetadata struct {
+	ChannelID       string `json:"channel_id,omitempty"`
+	DurationSeconds int    `json:"duration_seconds,omitempty"`
+	CustomMessage   string `json:"custom_message,omitempty"`
+}
+
+var automodTriggerTypes = map[string]int{
+	"keyword":        1,
+	"spam":           3,
+	"keyword_preset": 4,
+	"mention_spam":   5,
+	"member_profile": 6,
+}
+
+var automodKeywordPresets = map[string]int{
+	"profanity":      1,
+	"sexual_content": 2,
+	"slurs":          3,
+}
+
+var automodActionTypes = map[string]int{
+	"block_message":      1,
+	"send_alert_message": 2,
+	"timeout":            3,
+}
+
+const (
+	automodEventMessageSend  = 1
+	automodEventMemberUpdate = 2
+)
+
+func buildAutomodRule(ctx context.Context, plan automodRuleModel) *automodRule {
+	triggerType := plan.TriggerType.ValueString()
+	exemptRoles, _ := setStrings(ctx, plan.ExemptRoles)
+	exemptChannels, _ := setStrings(ctx, plan.ExemptChannels)
+	rule := &automodRule{
+		Name:           plan.Name.ValueString(),
+		EventType:      automodEventMessageSend,
+		TriggerType:    automodTriggerTypes[triggerType],
+		Enabled:        plan.Enabled.ValueBool(),
+		ExemptRoles:    exemptRoles,
+		ExemptChannels: exemptChannels,
+		Actions:        make([]automodAction, 0),
+	}
+	if triggerType == "member_profile" {
+		rule.EventType = automodEventMemberUpdate
+	}
+
+	keywordFilter := make([]string, 0)
+	regexPatterns := make([]string, 0)
+	presets := make([]int, 0)
+	allowList := make([]string, 0)
+	mentionTotalLimit := 0
+	mentionRaidProtectionEnabled := false
+	if len(plan.TriggerMetadata) > 0 {
+		metadata := plan.TriggerMetadata[0]
+		keywordFilter, _ = setStrings(ctx, metadata.KeywordFilter)
+		regexPatterns, _ = setStrings(ctx, metadata.RegexPatterns)
+		names, _ := setStrings(ctx, metadata.Presets)
+		for _, p := range names {
+			presets = append(presets, automodKeywordPresets[p])
+		}
+		allowList, _ = setStrings(ctx, metadata.AllowList)
+		mentionTotalLimit = int(metadata.MentionTotalLimit.ValueInt64())
+		mentionRaidProtectionEnabled = metadata.MentionRaidProtectionEnabled.ValueBool()
+	}
+
+	// Discord keeps the fields that are left out, so all the fields of the
+	// trigger type are sent, and cleared once they're removed.
+	rule.TriggerMetadata = &automodTriggerMetadata{}
+	switch triggerType {
+	case "keyword", "member_profile":
+		rule.TriggerMetadata.KeywordFilter = &keywordFilter
+		rule.TriggerMetadata.RegexPatterns = &regexPatterns
+		rule.TriggerMetadata.AllowList = &allowList
+	case "keyword_preset":
+		rule.TriggerMetadata.Presets = &presets
+		rule.TriggerMetadata.AllowList = &allowList
+	case "mention_spam":
+		rule.TriggerMetadata.MentionTotalLimit = &mentionTotalLimit
+		rule.TriggerMetadata.MentionRaidProtectionEnabled = &mentionRaidProtectionEnabled
+	}
+
+	for _, action := range plan.Action {
+		rule.Actions = append(rule.Actions, automodAction{
+			Type: automodActionTypes[action.Type.ValueString()],
+			Metadata: &automodActionMetadata{
+				ChannelID:       action.ChannelId.ValueString(),
+				DurationSeconds: int(action.DurationSeconds.ValueInt64()),
+				CustomMessage:   action.CustomMessage.ValueString(),
+			},
+		})
+	}
+
+	return rule
+}
+
+// setAutomodRule sets the attributes of m from rule, keeping the optional
+// values that Discord leaves empty null while they're null in m.
+func setAutomodRule(ctx context.Context, m *automodRuleModel, rule *automodRule) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	m.ServerId = types.StringValue(rule.GuildID)
+	m.Name = types.StringValue(rule.Name)
+	m.TriggerType = types.StringValue(getTextValue(automodTriggerTypes, rule.TriggerType))
+	m.Enabled = types.BoolValue(rule.Enabled)
+	m.ExemptRoles, d = setValue(ctx, rule.ExemptRoles, m.ExemptRoles)
+	diags.Append(d...)
+	m.ExemptChannels, d = setValue(ctx, rule.ExemptChannels, m.ExemptChannels)
+	diags.Append(d...)
+	m.CreatorId = types.StringValue(rule.CreatorID)
+
+	// Discord always returns the metadata, so it's only kept in the state
+	// when the block is there already or something is set on it.
+	if r := rule.TriggerMetadata; r != nil && (len(m.TriggerMetadata) > 0 || !r.isEmpty()) {
+		var prior automodTriggerMetadataModel
+		if len(m.TriggerMetadata) > 0 {
+			prior = m.TriggerMetadata[0]
+		}
+
+		var keywordFilter, regexPatterns, presets, allowList []string
+		if r.KeywordFilter != nil {
+			keywordFilter = *r.KeywordFilter
+		}
+		if r.RegexPatterns != nil {
+			regexPatterns = *r.RegexPatterns
+		}
+		if r.Presets != nil {
+			for _, p := range *r.Presets {
+				presets = append(presets, getTextValue(automodKeywordPresets, p))
+			}
+		}
+		if r.AllowList != nil {
+			allowList = *r.AllowList
+		}
+		mentionTotalLimit := 0
+		if r.MentionTotalLimit != nil {
+			mentionTotalLimit = *r.MentionTotalLimit
+		}
+		mentionRaidProtectionEnabled := false
+		if r.MentionRaidProtectionEnabled != nil {
+			mentionRaidProtectionEnabled = *r.MentionRaidProtectionEnabled
+		}
+
+		metadata := automodTriggerMetadataModel{
+			MentionTotalLimit:            int64Value(mentionTotalLimit, prior.MentionTotalLimit),
+			MentionRaidProtectionEnabled: boolValue(mentionRaidProtectionEnabled, prior.MentionRaidProtectionEnabled),
+		}
+		metadata.KeywordFilter, d = setValue(ctx, keywordFilter, prior.KeywordFilter)
+		diags.Append(d...)
+		metadata.RegexPatterns, d = setValue(ctx, regexPatterns, prior.RegexPatterns)
+		diags.Append(d...)
+		metadata.Presets, d = setValue(ctx, presets, prior.Presets)
+		diags.Append(d...)
+		metadata.AllowList, d = setValue(ctx, allowList, prior.AllowList)
+		diags.Append(d...)
+		m.TriggerMetadata = []automodTriggerMetadataModel{metadata}
+	} else {
+		m.TriggerMetadata = []automodTriggerMetadataModel{}
+	}
+
+	actions := make([]automodActionModel, 0, len(rule.Actions))
+	for i, a := range rule.Actions {
+		var prior automodActionModel
+		if i < len(m.Action) {
+			prior = m.Action[i]
+		}
+
+		metadata := a.Metadata
+		if metadata == nil {
+			metadata = &automodActionMetadata{}
+		}
+		actions = append(actions, automodActionModel{
+			Type:            types.StringValue(getTextValue(automodActionTypes, a.Type)),
+			CustomMessage:   stringValue(metadata.CustomMessage, prior.CustomMessage),
+			ChannelId:       stringValue(metadata.ChannelID, prior.ChannelId),
+			DurationSeconds: int64Value(metadata.DurationSeconds, prior.DurationSeconds),
+		})
+	}
+	m.Action = actions
+
+	return diags
+}
+
+// isEmpty returns whether nothing is set on the metadata.
+func (m *automodTriggerMetadata) isEmpty() bool {
+	return (m.KeywordFilter == nil || len(*m.KeywordFilter) == 0) &&
+		(m.RegexPatterns == nil || len(*m.RegexPatterns) == 0) &&
+		(m.Presets == nil || len(*m.Presets) == 0) &&
+		(m.AllowList == nil || len(*m.AllowList) == 0) &&
+		(m.MentionTotalLimit == nil || *m.MentionTotalLimit == 0) &&
+		(m.MentionRaidProtectionEnabled == nil || !*m.MentionRaidProtectionEnabled)
+}
+
+func requestAutomodRule(ctx context.Context, client *discordgo.Session, method string, endpoint string, rule *automodRule) (*automodRule, error) {
+	var data interface{}
+	if rule != nil {
+		data = rule
+	}
+
+	body, err := client.RequestWithBucketID(method, endpoint, data, endpoint, discordgo.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	var res automodRule
+	if err := json.Unmarshal(body, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (r *automodRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan automodRuleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverId := plan.ServerId.ValueString()
+	rule, err := requestAutomodRule(ctx, r.client, http.MethodPost, discordgo.EndpointGuildAutoModerationRules(serverId), buildAutomodRule(ctx, plan))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to create AutoMod rule in %s", serverId), err.Error())
+		return
+	}
+
+	plan.Id = types.StringValue(rule.ID)
+	plan.CreatorId = types.StringValue(rule.CreatorID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *automodRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state automodRuleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ruleId := state.Id.ValueString()
+	rule, err := requestAutomodRule(ctx, r.client, http.MethodGet, discordgo.EndpointGuildAutoModerationRule(state.ServerId.ValueString(), ruleId), nil)
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to fetch AutoMod rule %s", ruleId), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(setAutomodRule(ctx, &state, rule)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *automodRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan automodRuleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ruleId := plan.Id.ValueString()
+	if _, err := requestAutomodRule(ctx, r.client, http.MethodPatch, discordgo.EndpointGuildAutoModerationRule(plan.ServerId.ValueString(), ruleId), buildAutomodRule(ctx, plan)); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to update AutoMod rule %s", ruleId), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *automodRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state automodRuleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.AutoModerationRuleDelete(state.ServerId.ValueString(), state.Id.ValueString(), discordgo.WithContext(ctx)); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to delete AutoMod rule %s", state.Id.ValueString()), err.Error())
+	}
+}
@@ -0,0 +1,74 @@
+package discord
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceDiscordAutomodRule(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	if testServerID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID envvar must be set for acceptance tests")
+	}
+	name := "discord_automod_rule.example"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDiscordAutomodRule(testServerID, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "server_id", testServerID),
+					resource.TestCheckResourceAttr(name, "name", "terraform-automod-rule"),
+					resource.TestCheckResourceAttr(name, "trigger_type", "keyword"),
+					resource.TestCheckResourceAttr(name, "enabled", "true"),
+					resource.TestCheckResourceAttr(name, "trigger_metadata.0.keyword_filter.#", "2"),
+					resource.TestCheckResourceAttr(name, "trigger_metadata.0.allow_list.#", "1"),
+					resource.TestCheckResourceAttr(name, "action.#", "2"),
+					resource.TestCheckResourceAttr(name, "action.0.type", "block_message"),
+					resource.TestCheckResourceAttr(name, "action.0.custom_message", "Please keep it civil."),
+					resource.TestCheckResourceAttr(name, "action.1.type", "timeout"),
+					resource.TestCheckResourceAttr(name, "action.1.duration_seconds", "60"),
+					resource.TestCheckResourceAttrSet(name, "creator_id"),
+				),
+			},
+			{
+				Config: testAccResourceDiscordAutomodRule(testServerID, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "trigger_metadata.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceDiscordAutomodRule(serverID string, withMetadata bool) string {
+	metadata := ""
+	if withMetadata {
+		metadata = `
+      trigger_metadata {
+        keyword_filter = ["cursed*", "*badword*"]
+        allow_list = ["cursed image"]
+      }`
+	}
+
+	return fmt.Sprintf(`
+	resource "discord_automod_rule" "example" {
+	  server_id = "%[1]s"
+      name = "terraform-automod-rule"
+      trigger_type = "keyword"
+%[2]s
+
+      action {
+        type = "block_message"
+        custom_message = "Please keep it civil."
+      }
+      action {
+        type = "timeout"
+        duration_seconds = 60
+      }
+	}`, serverID, metadata)
+}
@@ -0,0 +1,165 @@
+package discord
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/net/context"
+)
+
+type banResource struct {
+	frameworkResource
+}
+
+type banModel struct {
+	ServerId             types.String `tfsdk:"server_id"`
+	UserId               types.String `tfsdk:"user_id"`
+	Reason               types.String `tfsdk:"reason"`
+	DeleteMessageSeconds types.Int64  `tfsdk:"delete_message_seconds"`
+	Id                   types.String `tfsdk:"id"`
+}
+
+func resourceDiscordBan() resource.Resource {
+	return &banResource{}
+}
+
+func (r *banResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ban"
+}
+
+func (r *banResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// Version 1 moved the resource from the SDK to
+		// terraform-plugin-framework.
+		Version:     1,
+		Description: "A resource to ban a user from a server. Destroying the resource lifts the ban.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "ID of the server to ban the user from.",
+			},
+			"user_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "ID of the user to ban.",
+			},
+			"reason": schema.StringAttribute{
+				Optional:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "Reason for the ban, shown in the audit log and the server's ban list.",
+			},
+			"delete_message_seconds": schema.Int64Attribute{
+				Optional:      true,
+				Computed:      true,
+				Default:       int64default.StaticInt64(0),
+				Validators:    []validator.Int64{int64validator.Between(0, 604800)},
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+				Description:   "Number of seconds of messages by the user to delete when banning them, up to 7 days.",
+			},
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The ID of the ban, in the format `server_id:user_id`.",
+			},
+		},
+	}
+}
+
+func (r *banResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
+
+	return map[int64]resource.StateUpgrader{0: upgradeFromSDK(resp.Schema)}
+}
+
+func (r *banResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	serverId, userId, err := parseTwoIds(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import ban", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), serverId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), userId)...)
+	// The messages deleted with the ban can't be fetched.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("delete_message_seconds"), types.Int64Value(0))...)
+}
+
+func (r *banResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan banModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverId := plan.ServerId.ValueString()
+	userId := plan.UserId.ValueString()
+
+	// discordgo only supports the deprecated delete_message_days, so the ban is
+	// made directly.
+	if _, err := r.client.RequestWithBucketID(http.MethodPut, discordgo.EndpointGuildBan(serverId, userId), map[string]interface{}{
+		"delete_message_seconds": plan.DeleteMessageSeconds.ValueInt64(),
+	}, discordgo.EndpointGuildBan(serverId, ""), discordgo.WithContext(ctx), discordgo.WithAuditLogReason(plan.Reason.ValueString())); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to ban user %s from %s", userId, serverId), err.Error())
+		return
+	}
+
+	plan.Id = types.StringValue(generateTwoPartId(serverId, userId))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *banResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state banModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverId := state.ServerId.ValueString()
+	userId := state.UserId.ValueString()
+	ban, err := r.client.GuildBan(serverId, userId, discordgo.WithContext(ctx))
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to fetch ban of user %s in %s", userId, serverId), err.Error())
+		return
+	}
+
+	state.UserId = types.StringValue(ban.User.ID)
+	state.Reason = stringValue(ban.Reason, state.Reason)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called, as every attribute requires the ban to be replaced.
+func (r *banResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+}
+
+func (r *banResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state banModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userId := state.UserId.ValueString()
+	if err := r.client.GuildBanDelete(state.ServerId.ValueString(), userId, discordgo.WithContext(ctx)); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to unban user %s", userId), err.Error())
+	}
+}
@@ -0,0 +1,53 @@
+package discord
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceDiscordBan(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	testUserID := os.Getenv("DISCORD_TEST_BAN_USER_ID")
+	if testServerID == "" || testUserID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID and DISCORD_TEST_BAN_USER_ID envvars must be set for acceptance tests")
+	}
+	name := "discord_ban.example"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDiscordBan(testServerID, testUserID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "id", testServerID+":"+testUserID),
+					resource.TestCheckResourceAttr(name, "server_id", testServerID),
+					resource.TestCheckResourceAttr(name, "user_id", testUserID),
+					resource.TestCheckResourceAttr(name, "reason", "Terraform acceptance test"),
+					resource.TestCheckResourceAttr(name, "delete_message_seconds", "3600"),
+					resource.TestCheckResourceAttr("data.discord_bans.example", "bans.#", "1"),
+					resource.TestCheckResourceAttr("data.discord_bans.example", "bans.0.user_id", testUserID),
+					resource.TestCheckResourceAttr("data.discord_bans.example", "bans.0.reason", "Terraform acceptance test"),
+					resource.TestCheckTypeSetElemAttr("data.discord_bans.example", "user_ids.*", testUserID),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceDiscordBan(serverID string, userID string) string {
+	return fmt.Sprintf(`
+	resource "discord_ban" "example" {
+	  server_id = "%[1]s"
+      user_id = "%[2]s"
+      reason = "Terraform acceptance test"
+      delete_message_seconds = 3600
+	}
+
+	data "discord_bans" "example" {
+	  server_id = discord_ban.example.server_id
+	  depends_on = [discord_ban.example]
+	}`, serverID, userID)
+}
@@ -1,19 +1,12 @@
 package discord
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
-func resourceDiscordCategoryChannel() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: resourceChannelCreate,
-		ReadContext:   resourceChannelRead,
-		UpdateContext: resourceChannelUpdate,
-		DeleteContext: resourceChannelDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-		Description: "A resource to create a category channel.",
-		Schema:      getChannelSchema("category", nil),
+func resourceDiscordCategoryChannel() resource.Resource {
+	return &channelResource{
+		channelType: "category",
+		description: "A resource to create a category channel.",
 	}
 }
@@ -15,8 +15,8 @@ func TestAccResourceDiscordCategoryChannel(t *testing.T) {
 	}
 	name := "discord_category_channel.example"
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceDiscordSystemChannel(testServerID),
@@ -1,358 +1,770 @@
 package discord
 
 import (
-	"errors"
+	"context"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/bwmarrin/discordgo"
-	"github.com/hashicorp/go-cty/cty"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"golang.org/x/net/context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-func getChannelSchema(channelType string, s map[string]*schema.Schema) map[string]*schema.Schema {
-	addedSchema := map[string]*schema.Schema{
-		"server_id": {
-			Type:        schema.TypeString,
+var channelTypes = []string{"text", "voice", "news", "category", "stage", "forum", "media"}
+
+// channelTypeFields lists the type specific settings of discord_channel and
+// the types of channels they can be set on.
+var channelTypeFields = []struct {
+	key   string
+	types []string
+}{
+	{"category", []string{"text", "voice", "news", "stage", "forum", "media"}},
+	{"sync_perms_with_category", []string{"text", "voice", "news", "stage", "forum", "media"}},
+	{"topic", []string{"text", "news", "forum", "media"}},
+	{"nsfw", []string{"text", "voice", "news", "forum", "media"}},
+	{"rate_limit_per_user", []string{"text", "news"}},
+	{"default_auto_archive_duration", []string{"text", "news"}},
+	{"bitrate", []string{"voice", "stage"}},
+	{"user_limit", []string{"voice", "stage"}},
+	{"rtc_region", []string{"voice", "stage"}},
+	{"video_quality_mode", []string{"voice"}},
+	{"available_tags", []string{"forum", "media"}},
+	{"default_reaction_emoji", []string{"forum", "media"}},
+	{"default_sort_order", []string{"forum", "media"}},
+	{"default_forum_layout", []string{"forum", "media"}},
+	{"default_thread_rate_limit_per_user", []string{"text", "news", "forum", "media"}},
+}
+
+// channelResource is discord_channel and the resources of a single type of
+// channel, like discord_text_channel.
+type channelResource struct {
+	frameworkResource
+	// channelType is the type of the channels of the resource, or empty for
+	// discord_channel, which has a type argument.
+	channelType string
+	description string
+	// attributes and blocks are the settings of the type of channel.
+	attributes map[string]schema.Attribute
+	blocks     map[string]schema.Block
+}
+
+// channelModel holds the attributes of all the channel resources. Each of
+// them only has some of the attributes, so they're read and written one by one
+// instead of all at once, see channelResource.get and channelResource.set.
+type channelModel struct {
+	ServerId                      types.String
+	Id                            types.String
+	ChannelId                     types.String
+	Type                          types.String
+	Name                          types.String
+	Position                      types.Int64
+	Category                      types.String
+	SyncPermsWithCategory         types.Bool
+	Topic                         types.String
+	NSFW                          types.Bool
+	Bitrate                       types.Int64
+	UserLimit                     types.Int64
+	RTCRegion                     types.String
+	VideoQualityMode              types.String
+	RateLimitPerUser              types.Int64
+	DefaultAutoArchiveDuration    types.Int64
+	DefaultThreadRateLimitPerUser types.Int64
+	AvailableTags                 []forumTagModel
+	DefaultReactionEmoji          []forumReactionModel
+	DefaultSortOrder              types.String
+	DefaultForumLayout            types.String
+}
+
+type forumTagModel struct {
+	Id        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Moderated types.Bool   `tfsdk:"moderated"`
+	EmojiId   types.String `tfsdk:"emoji_id"`
+	EmojiName types.String `tfsdk:"emoji_name"`
+}
+
+type forumReactionModel struct {
+	EmojiId   types.String `tfsdk:"emoji_id"`
+	EmojiName types.String `tfsdk:"emoji_name"`
+}
+
+// fields returns the fields of m by the names of their attributes.
+func (m *channelModel) fields() map[string]interface{} {
+	return map[string]interface{}{
+		"server_id":                          &m.ServerId,
+		"id":                                 &m.Id,
+		"channel_id":                         &m.ChannelId,
+		"type":                               &m.Type,
+		"name":                               &m.Name,
+		"position":                           &m.Position,
+		"category":                           &m.Category,
+		"sync_perms_with_category":           &m.SyncPermsWithCategory,
+		"topic":                              &m.Topic,
+		"nsfw":                               &m.NSFW,
+		"bitrate":                            &m.Bitrate,
+		"user_limit":                         &m.UserLimit,
+		"rtc_region":                         &m.RTCRegion,
+		"video_quality_mode":                 &m.VideoQualityMode,
+		"rate_limit_per_user":                &m.RateLimitPerUser,
+		"default_auto_archive_duration":      &m.DefaultAutoArchiveDuration,
+		"default_thread_rate_limit_per_user": &m.DefaultThreadRateLimitPerUser,
+		"available_tags":                     &m.AvailableTags,
+		"default_reaction_emoji":             &m.DefaultReactionEmoji,
+		"default_sort_order":                 &m.DefaultSortOrder,
+		"default_forum_layout":               &m.DefaultForumLayout,
+	}
+}
+
+// isSet reports whether the attribute name is set in m. Blocks are set when
+// they have any items.
+func (m *channelModel) isSet(name string) bool {
+	switch v := m.fields()[name].(type) {
+	case *[]forumTagModel:
+		return len(*v) > 0
+	case *[]forumReactionModel:
+		return len(*v) > 0
+	case attr.Value:
+		return !v.IsNull()
+	}
+
+	return false
+}
+
+func resourceDiscordChannel() resource.Resource {
+	attributes, blocks := getForumChannelSchema(" Only for `forum` and `media` channels.")
+	attributes["topic"] = schema.StringAttribute{
+		Optional:    true,
+		Description: "Topic of the channel, or the post guidelines of forum and media channels. Only for `text`, `news`, `forum` and `media` channels.",
+	}
+	attributes["nsfw"] = schema.BoolAttribute{
+		Optional:    true,
+		Computed:    true,
+		Default:     booldefault.StaticBool(false),
+		Description: "Whether the channel is NSFW. Only for `text`, `voice`, `news`, `forum` and `media` channels.",
+	}
+	attributes["bitrate"] = schema.Int64Attribute{
+		Optional:      true,
+		Computed:      true,
+		PlanModifiers: []planmodifier.Int64{useStateForUnknownOrNull()},
+		Description:   "Bitrate of the channel. Only for `voice` and `stage` channels. (default `64000`)",
+	}
+	attributes["user_limit"] = schema.Int64Attribute{
+		Optional:    true,
+		Description: "User limit of the channel. Only for `voice` and `stage` channels.",
+	}
+	attributes["rtc_region"] = schema.StringAttribute{
+		Optional:    true,
+		Description: "Voice region of the channel. Discord picks one automatically when not set. Only for `voice` and `stage` channels.",
+	}
+	attributes["video_quality_mode"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Default:     stringdefault.StaticString("auto"),
+		Validators:  []validator.String{stringvalidator.OneOf("auto", "full")},
+		Description: "Video quality of the channel, either `auto` or `full`. Only for `voice` channels.",
+	}
+	attributes["rate_limit_per_user"] = schema.Int64Attribute{
+		Optional:    true,
+		Computed:    true,
+		Default:     int64default.StaticInt64(0),
+		Validators:  []validator.Int64{int64validator.Between(0, 21600)},
+		Description: "Slowmode in seconds of the channel. Only for `text` and `news` channels.",
+	}
+	attributes["default_auto_archive_duration"] = schema.Int64Attribute{
+		Optional:      true,
+		Computed:      true,
+		Validators:    []validator.Int64{int64validator.OneOf(60, 1440, 4320, 10080)},
+		PlanModifiers: []planmodifier.Int64{useStateForUnknownOrNull()},
+		Description:   "Minutes of inactivity after which new threads of the channel are archived, one of `60`, `1440`, `4320` or `10080`. Only for `text` and `news` channels.",
+	}
+	attributes["default_thread_rate_limit_per_user"] = schema.Int64Attribute{
+		Optional:    true,
+		Computed:    true,
+		Default:     int64default.StaticInt64(0),
+		Validators:  []validator.Int64{int64validator.Between(0, 21600)},
+		Description: "Slowmode in seconds applied to new threads of the channel, or new posts of forum and media channels. Only for `text`, `news`, `forum` and `media` channels.",
+	}
+
+	return &channelResource{
+		description: "A resource to create a channel of any type.",
+		attributes:  attributes,
+		blocks:      blocks,
+	}
+}
+
+func (r *channelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	if r.channelType == "" {
+		resp.TypeName = req.ProviderTypeName + "_channel"
+		return
+	}
+
+	resp.TypeName = req.ProviderTypeName + "_" + r.channelType + "_channel"
+}
+
+func (r *channelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes, blocks := r.schema()
+	resp.Schema = schema.Schema{
+		// Version 1 moved the resource from the SDK to
+		// terraform-plugin-framework.
+		Version:     1,
+		Description: r.description,
+		Attributes:  attributes,
+		Blocks:      blocks,
+	}
+}
+
+func (r *channelResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
+
+	return map[int64]resource.StateUpgrader{
+		0: upgradeFromSDK(resp.Schema),
+	}
+}
+
+// schema returns the attributes and blocks of the resource, which are the
+// settings of its type of channel and the ones every channel has.
+func (r *channelResource) schema() (map[string]schema.Attribute, map[string]schema.Block) {
+	attributes := map[string]schema.Attribute{
+		"server_id": schema.StringAttribute{
 			Required:    true,
 			Description: "ID of server this channel is in.",
 		},
-		"id": {
-			Type:        schema.TypeString,
-			Computed:    true,
-			Description: "The ID of the channel.",
+		"id": schema.StringAttribute{
+			Computed:      true,
+			PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			Description:   "The ID of the channel.",
 		},
-		"channel_id": {
-			Type:        schema.TypeString,
-			Computed:    true,
-			Description: "The ID of the channel.",
+		"channel_id": schema.StringAttribute{
+			Computed:      true,
+			PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			Description:   "The ID of the channel.",
 		},
-		"type": {
-			Type:        schema.TypeString,
-			Required:    true,
+		"type": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString(r.channelType),
+			Validators:  []validator.String{stringvalidator.OneOf(r.channelType)},
 			Description: "The type of the channel. This is only for internal use and should never be provided.",
-			ValidateDiagFunc: func(i interface{}, path cty.Path) (diags diag.Diagnostics) {
-				if i.(string) != channelType {
-					diags = append(diags, diag.Errorf("type must be %s, %s passed", channelType, i.(string))...)
-				}
-
-				return diags
-			},
-			DefaultFunc: func() (interface{}, error) {
-				return channelType, nil
-			},
 		},
-		"name": {
-			Type:        schema.TypeString,
+		"name": schema.StringAttribute{
 			Required:    true,
 			Description: "Name of the channel.",
 		},
-		"position": {
-			Type:        schema.TypeInt,
-			Default:     1,
+		"position": schema.Int64Attribute{
 			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(1),
+			Validators:  []validator.Int64{int64validator.AtLeast(0)},
 			Description: "Position of the channel, `0`-indexed.",
-			ValidateFunc: func(val interface{}, key string) (warns []string, errors []error) {
-				v := val.(int)
-
-				if v < 0 {
-					errors = append(errors, fmt.Errorf("position must be greater than 0, got: %d", v))
-				}
-
-				return
-			},
 		},
 	}
+	if r.channelType == "" {
+		attributes["type"] = schema.StringAttribute{
+			Required:   true,
+			Validators: []validator.String{stringvalidator.OneOf(channelTypes...)},
+			PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIf(
+				func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+					resp.RequiresReplace = !isNewsConversion(req.StateValue.ValueString(), req.PlanValue.ValueString())
+				},
+				"Text channels are converted to news channels and back in place, other changes replace the channel.",
+				"Text channels are converted to news channels and back in place, other changes replace the channel.",
+			)},
+			Description: "Type of the channel, one of `text`, `voice`, `news`, `category`, `stage`, `forum` or `media`. Text channels can be converted to news channels and back in place, other changes replace the channel.",
+		}
+	}
 
-	if channelType != "category" {
-		addedSchema["category"] = &schema.Schema{
-			Type:        schema.TypeString,
+	if r.channelType != "category" {
+		suffix := ""
+		if r.channelType == "" {
+			suffix = " Not for `category` channels."
+		}
+		attributes["category"] = schema.StringAttribute{
 			Optional:    true,
-			Description: "ID of category to place this channel in.",
+			Description: "ID of category to place this channel in." + suffix,
 		}
-		addedSchema["sync_perms_with_category"] = &schema.Schema{
-			Type:        schema.TypeBool,
+		attributes["sync_perms_with_category"] = schema.BoolAttribute{
 			Optional:    true,
-			Default:     true,
-			Description: "Whether channel permissions should be synced with the category this channel is in.",
+			Computed:    true,
+			Default:     booldefault.StaticBool(true),
+			Description: "Whether channel permissions should be synced with the category this channel is in." + suffix,
 		}
 	}
 
-	for k, v := range s {
-		addedSchema[k] = v
+	for k, v := range r.attributes {
+		attributes[k] = v
+	}
+	blocks := map[string]schema.Block{}
+	for k, v := range r.blocks {
+		blocks[k] = v
 	}
 
-	return addedSchema
+	return attributes, blocks
 }
 
-func validateChannel(d *schema.ResourceData) (bool, error) {
-	channelType := d.Get("type").(string)
+func isNewsConversion(from string, to string) bool {
+	return (from == "text" && to == "news") || (from == "news" && to == "text")
+}
 
-	switch channelType {
-	case "category":
-		{
-			if _, ok := d.GetOk("category"); ok {
-				return false, errors.New("category cannot be a child of another category")
-			}
-			if _, ok := d.GetOk("nsfw"); ok {
-				return false, errors.New("nsfw is not allowed on categories")
-			}
-		}
-	case "voice":
-		{
-			if _, ok := d.GetOk("topic"); ok {
-				return false, errors.New("topic is not allowed on voice channels")
-			}
-			if _, ok := d.GetOk("nsfw"); ok {
-				return false, errors.New("nsfw is not allowed on voice channels")
-			}
+// channelData is the plan, state or config of a channel resource.
+type channelData interface {
+	GetAttribute(ctx context.Context, p path.Path, target interface{}) diag.Diagnostics
+}
+
+// fields returns the fields of m that the resource has an attribute or block
+// for, by their names.
+func (r *channelResource) fields(m *channelModel) map[string]interface{} {
+	attributes, blocks := r.schema()
+	fields := map[string]interface{}{}
+	for name, field := range m.fields() {
+		_, isAttribute := attributes[name]
+		_, isBlock := blocks[name]
+		if isAttribute || isBlock {
+			fields[name] = field
 		}
-	case "text", "news":
-		{
-			if _, ok := d.GetOk("bitrate"); ok {
-				return false, errors.New("bitrate is not allowed on text channels")
-			}
-			if _, ok := d.GetOk("user_limit"); ok {
-				if d.Get("user_limit").(int) > 0 {
-					return false, errors.New("user_limit is not allowed on text channels")
-				}
-			}
-			name := d.Get("name").(string)
-			if strings.ToLower(name) != name {
-				return false, errors.New("name must be lowercase")
+	}
+
+	return fields
+}
+
+// get reads the attributes of the resource from data into m.
+func (r *channelResource) get(ctx context.Context, data channelData, m *channelModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for name, field := range r.fields(m) {
+		switch field.(type) {
+		case *[]forumTagModel, *[]forumReactionModel:
+			// Blocks are unknown in the config when they're dynamic, and
+			// left empty until they're known.
+			var list types.List
+			if diags.Append(data.GetAttribute(ctx, path.Root(name), &list)...); list.IsUnknown() {
+				continue
 			}
 		}
+		diags.Append(data.GetAttribute(ctx, path.Root(name), field)...)
 	}
 
-	return true, nil
+	return diags
 }
 
-func resourceChannelCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	client := m.(*Context).Session
+// set writes the attributes of the resource from m into state.
+func (r *channelResource) set(ctx context.Context, state *tfsdk.State, m *channelModel) diag.Diagnostics {
+	// Blocks that aren't set are empty rather than null.
+	if m.AvailableTags == nil {
+		m.AvailableTags = []forumTagModel{}
+	}
+	if m.DefaultReactionEmoji == nil {
+		m.DefaultReactionEmoji = []forumReactionModel{}
+	}
 
-	if ok, reason := validateChannel(d); !ok {
-		return diag.FromErr(reason)
+	var diags diag.Diagnostics
+	for name, field := range r.fields(m) {
+		diags.Append(state.SetAttribute(ctx, path.Root(name), field)...)
 	}
 
-	serverId := d.Get("server_id").(string)
-	channelType := d.Get("type").(string)
-	channelTypeInt, okay := getDiscordChannelType(channelType)
-	if !okay {
-		return diag.Errorf("Invalid channel type: %s", channelType)
+	return diags
+}
+
+// ValidateConfig rejects the settings that don't apply to the type of the
+// channel.
+func (r *channelResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config channelModel
+	resp.Diagnostics.Append(r.get(ctx, req.Config, &config)...)
+	if resp.Diagnostics.HasError() || config.Type.IsUnknown() {
+		return
 	}
 
-	var (
-		topic     string
-		bitrate   = 64000
-		userlimit int
-		nsfw      bool
-		parentId  string
-	)
+	channelType := r.channelType
+	if channelType == "" {
+		channelType = config.Type.ValueString()
+		for _, field := range channelTypeFields {
+			if config.isSet(field.key) && !contains(field.types, channelType) {
+				resp.Diagnostics.AddAttributeError(path.Root(field.key), "Invalid Attribute Combination", fmt.Sprintf("%s is not allowed on %s channels", field.key, channelType))
+			}
+		}
+	}
 
 	switch channelType {
-	case "text", "news":
-		{
-			if v, ok := d.GetOk("topic"); ok {
-				topic = v.(string)
-			}
-			if v, ok := d.GetOk("nsfw"); ok {
-				nsfw = v.(bool)
-			}
+	case "category":
+		if config.Category.ValueString() != "" {
+			resp.Diagnostics.AddAttributeError(path.Root("category"), "Invalid Attribute Combination", "category cannot be a child of another category")
 		}
-	case "voice":
-		{
-			if v, ok := d.GetOk("bitrate"); ok {
-				bitrate = v.(int)
-			}
-			if v, ok := d.GetOk("user_limit"); ok {
-				userlimit = v.(int)
-			}
+		if config.NSFW.ValueBool() {
+			resp.Diagnostics.AddAttributeError(path.Root("nsfw"), "Invalid Attribute Combination", "nsfw is not allowed on categories")
+		}
+	case "voice", "stage":
+		if config.Topic.ValueString() != "" {
+			resp.Diagnostics.AddAttributeError(path.Root("topic"), "Invalid Attribute Combination", "topic is not allowed on voice channels")
+		}
+		if config.NSFW.ValueBool() && channelType == "stage" {
+			resp.Diagnostics.AddAttributeError(path.Root("nsfw"), "Invalid Attribute Combination", "nsfw is not allowed on stage channels")
+		}
+	case "text", "news", "forum", "media":
+		if config.Bitrate.ValueInt64() != 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("bitrate"), "Invalid Attribute Combination", "bitrate is not allowed on text channels")
+		}
+		if config.UserLimit.ValueInt64() > 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("user_limit"), "Invalid Attribute Combination", "user_limit is not allowed on text channels")
+		}
+		if name := config.Name.ValueString(); strings.ToLower(name) != name {
+			resp.Diagnostics.AddAttributeError(path.Root("name"), "Invalid Attribute Value", "name must be lowercase")
 		}
 	}
+}
 
-	isCategoryCh := channelType == "category"
+func (r *channelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
 
-	if !isCategoryCh {
-		if v, ok := d.GetOk("category"); ok {
-			parentId = v.(string)
-		}
+func (r *channelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan channelModel
+	resp.Diagnostics.Append(r.get(ctx, req.Plan, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	channel, err := client.GuildChannelCreateComplex(serverId, discordgo.GuildChannelCreateData{
-		Name:      d.Get("name").(string),
-		Type:      channelTypeInt,
-		Topic:     topic,
-		Bitrate:   bitrate,
-		UserLimit: userlimit,
-		ParentID:  parentId,
-		NSFW:      nsfw,
-	}, discordgo.WithContext(ctx))
 
-	if err != nil {
-		return diag.Errorf("Failed to create channel: %s", err.Error())
+	serverId := plan.ServerId.ValueString()
+	channelType := plan.Type.ValueString()
+	channelTypeInt, ok := getDiscordChannelType(channelType)
+	if !ok {
+		resp.Diagnostics.AddError("Invalid channel type", channelType)
+		return
 	}
 
-	d.SetId(channel.ID)
-	d.Set("server_id", serverId)
-	d.Set("channel_id", channel.ID)
-
-	if !isCategoryCh {
-		if v, ok := d.GetOk("sync_perms_with_category"); ok && v.(bool) {
-			if channel.ParentID == "" {
-				return append(diags, diag.Errorf("Can't sync permissions with category. Channel (%s) doesn't have a category", channel.ID)...)
-			}
-			parent, err := client.Channel(channel.ParentID)
-			if err != nil {
-				return append(diags, diag.Errorf("Can't sync permissions with category. Channel (%s) doesn't have a category", channel.ID)...)
-			}
+	var (
+		topic            string
+		bitrate          = 64000
+		userLimit        int
+		nsfw             bool
+		rateLimitPerUser int
+		parentId         string
+	)
 
-			if err = syncChannelPermissions(client, ctx, parent, channel); err != nil {
-				return append(diags, diag.Errorf("Can't sync permissions with category: %s", channel.ID)...)
-			}
+	switch channelType {
+	case "text", "news", "forum", "media":
+		topic = plan.Topic.ValueString()
+		nsfw = plan.NSFW.ValueBool()
+		rateLimitPerUser = int(plan.RateLimitPerUser.ValueInt64())
+	case "voice", "stage":
+		if v := plan.Bitrate.ValueInt64(); v != 0 {
+			bitrate = int(v)
 		}
+		userLimit = int(plan.UserLimit.ValueInt64())
+		nsfw = plan.NSFW.ValueBool()
+	}
+	if channelType != "category" {
+		parentId = plan.Category.ValueString()
 	}
 
-	return diags
+	channel, err := r.client.GuildChannelCreateComplex(serverId, discordgo.GuildChannelCreateData{
+		Name:             plan.Name.ValueString(),
+		Type:             channelTypeInt,
+		Position:         int(plan.Position.ValueInt64()),
+		Topic:            topic,
+		Bitrate:          bitrate,
+		UserLimit:        userLimit,
+		RateLimitPerUser: rateLimitPerUser,
+		ParentID:         parentId,
+		NSFW:             nsfw,
+	}, discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to create channel in %s", serverId), err.Error())
+		return
+	}
+
+	plan.Id = types.StringValue(channel.ID)
+	plan.ChannelId = types.StringValue(channel.ID)
+
+	resp.Diagnostics.Append(r.update(ctx, channel, plan, channelModel{})...)
+	resp.Diagnostics.Append(r.setComputed(ctx, &plan)...)
+	resp.Diagnostics.Append(r.set(ctx, &resp.State, &plan)...)
 }
 
-func resourceChannelRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	client := m.(*Context).Session
+func (r *channelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state channelModel
+	resp.Diagnostics.Append(r.get(ctx, req.State, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	channel, err := client.Channel(d.Id(), discordgo.WithContext(ctx))
+	channel, settings, err := getChannelWithSettings(r.client, ctx, state.Id.ValueString())
 	if err != nil {
-		return diag.Errorf("Failed to fetch channel %s: %s", d.Id(), err.Error())
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to fetch channel %s", state.Id.ValueString()), err.Error())
+		return
 	}
 
 	channelType, ok := getTextChannelType(channel.Type)
 	if !ok {
-		return diag.Errorf("Invalid channel type: %d", channel.Type)
+		resp.Diagnostics.AddError("Invalid channel type", fmt.Sprintf("Channel %s has the unknown type %d", channel.ID, channel.Type))
+		return
 	}
 
-	d.Set("server_id", channel.GuildID)
-	d.Set("type", channelType)
-	d.Set("name", channel.Name)
-	d.Set("position", channel.Position)
+	state.ServerId = types.StringValue(channel.GuildID)
+	state.ChannelId = types.StringValue(channel.ID)
+	state.Type = types.StringValue(channelType)
+	state.Name = types.StringValue(channel.Name)
+	state.Position = types.Int64Value(int64(channel.Position))
 
 	switch channelType {
-	case "text":
-		{
-			d.Set("topic", channel.Topic)
-			d.Set("nsfw", channel.NSFW)
-		}
-	case "news":
-		d.Set("topic", channel.Topic)
-	case "voice":
-		{
-			d.Set("bitrate", channel.Bitrate)
-			d.Set("user_limit", channel.UserLimit)
+	case "text", "news":
+		state.Topic = stringValue(channel.Topic, state.Topic)
+		state.NSFW = types.BoolValue(channel.NSFW)
+		state.RateLimitPerUser = types.Int64Value(int64(channel.RateLimitPerUser))
+		state.DefaultThreadRateLimitPerUser = types.Int64Value(int64(channel.DefaultThreadRateLimitPerUser))
+		state.DefaultAutoArchiveDuration = types.Int64Value(int64(settings.DefaultAutoArchiveDuration))
+	case "forum", "media":
+		state.Topic = stringValue(channel.Topic, state.Topic)
+		state.NSFW = types.BoolValue(channel.NSFW)
+		state.AvailableTags = unbuildForumTags(channel.AvailableTags, state.AvailableTags)
+		state.DefaultReactionEmoji = unbuildForumDefaultReaction(channel.DefaultReactionEmoji, state.DefaultReactionEmoji)
+		state.DefaultSortOrder = stringValue(getTextForumSortOrder(channel.DefaultSortOrder), state.DefaultSortOrder)
+		state.DefaultForumLayout = types.StringValue(getTextForumLayout(channel.DefaultForumLayout))
+		state.DefaultThreadRateLimitPerUser = types.Int64Value(int64(channel.DefaultThreadRateLimitPerUser))
+	case "voice", "stage":
+		state.Bitrate = types.Int64Value(int64(channel.Bitrate))
+		state.UserLimit = int64Value(channel.UserLimit, state.UserLimit)
+		state.RTCRegion = stringValue(settings.RTCRegion, state.RTCRegion)
+
+		if channelType == "voice" {
+			state.NSFW = types.BoolValue(channel.NSFW)
+			// Discord leaves the video quality out until it's changed.
+			if mode := getTextValue(videoQualityModes, settings.VideoQualityMode); mode != "" {
+				state.VideoQualityMode = types.StringValue(mode)
+			} else {
+				state.VideoQualityMode = types.StringValue("auto")
+			}
 		}
 	}
 
 	if channelType != "category" {
 		if channel.ParentID == "" {
-			d.Set("sync_perms_with_category", false)
+			state.SyncPermsWithCategory = types.BoolValue(false)
 		} else {
-			parent, err := client.Channel(channel.ParentID)
+			parent, err := r.client.Channel(channel.ParentID, discordgo.WithContext(ctx))
 			if err != nil {
-				return diag.Errorf("Failed to fetch category of channel %s: %s", channel.ID, err.Error())
+				resp.Diagnostics.AddError(fmt.Sprintf("Failed to fetch category of channel %s", channel.ID), err.Error())
+				return
 			}
 
-			synced := arePermissionsSynced(channel, parent)
-			d.Set("sync_perms_with_category", synced)
+			state.SyncPermsWithCategory = types.BoolValue(arePermissionsSynced(channel, parent))
 		}
 	}
 
 	if channel.ParentID == "" {
-		d.Set("category", nil)
+		state.Category = types.StringNull()
 	} else {
-		d.Set("category", channel.ParentID)
+		state.Category = types.StringValue(channel.ParentID)
 	}
 
-	return diags
+	resp.Diagnostics.Append(r.set(ctx, &resp.State, &state)...)
 }
 
-func resourceChannelUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	client := m.(*Context).Session
-	if ok, reason := validateChannel(d); !ok {
-		return diag.FromErr(reason)
+func (r *channelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state channelModel
+	resp.Diagnostics.Append(r.get(ctx, req.Plan, &plan)...)
+	resp.Diagnostics.Append(r.get(ctx, req.State, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	channel, _ := client.Channel(d.Id(), discordgo.WithContext(ctx))
-	channelType := d.Get("type").(string)
+	channelId := plan.Id.ValueString()
+	channelType := plan.Type.ValueString()
+	if !plan.Type.Equal(state.Type) {
+		// ChannelEdit can't change the type, which only works between text
+		// and news channels.
+		channelTypeInt, _ := getDiscordChannelType(channelType)
+		endpoint := discordgo.EndpointChannel(channelId)
+		if _, err := r.client.RequestWithBucketID(http.MethodPatch, endpoint, map[string]interface{}{"type": channelTypeInt}, endpoint, discordgo.WithContext(ctx)); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to convert channel %s to a %s channel", channelId, channelType), err.Error())
+			return
+		}
+	}
 
-	var (
-		name      string
-		position  int
-		topic     string
-		nsfw      bool
-		bitRate   = 64000
-		userLimit int
-		parentId  string
-	)
+	channel, err := r.client.Channel(channelId, discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to fetch channel %s", channelId), err.Error())
+		return
+	}
 
-	name = map[bool]string{true: d.Get("name").(string), false: channel.Name}[d.HasChange("name")]
-	position = map[bool]int{true: int(d.Get("position").(int)), false: int(channel.Position)}[d.HasChange("position")]
+	// Settings that didn't change are sent as Discord has them.
+	edit := &discordgo.ChannelEdit{
+		Name:     channel.Name,
+		Position: &channel.Position,
+		Bitrate:  64000,
+	}
+	if !plan.Name.Equal(state.Name) {
+		edit.Name = plan.Name.ValueString()
+	}
+	if !plan.Position.Equal(state.Position) {
+		edit.Position = IntPtr(int(plan.Position.ValueInt64()))
+	}
 
 	switch channelType {
-	case "text", "news":
-		{
-			topic = map[bool]string{true: d.Get("topic").(string), false: channel.Topic}[d.HasChange("topic")]
-			nsfw = map[bool]bool{true: d.Get("nsfw").(bool), false: channel.NSFW}[d.HasChange("nsfw")]
+	case "text", "news", "forum", "media":
+		edit.Topic = channel.Topic
+		if !plan.Topic.Equal(state.Topic) {
+			edit.Topic = plan.Topic.ValueString()
+		}
+		// discord_news_channel has no nsfw, so it's only read on change.
+		edit.NSFW = BoolPtr(channel.NSFW)
+		if !plan.NSFW.Equal(state.NSFW) {
+			edit.NSFW = BoolPtr(plan.NSFW.ValueBool())
+		}
+		if (channelType == "text" || channelType == "news") && !plan.RateLimitPerUser.Equal(state.RateLimitPerUser) {
+			edit.RateLimitPerUser = IntPtr(int(plan.RateLimitPerUser.ValueInt64()))
+		}
+	case "voice", "stage":
+		edit.Bitrate = channel.Bitrate
+		if !plan.Bitrate.Equal(state.Bitrate) {
+			edit.Bitrate = int(plan.Bitrate.ValueInt64())
 		}
-	case "voice":
-		{
-			bitRate = map[bool]int{true: int(d.Get("bitrate").(int)), false: channel.Bitrate}[d.HasChange("bitrate")]
-			userLimit = map[bool]int{true: int(d.Get("user_limit").(int)), false: channel.UserLimit}[d.HasChange("user_limit")]
+		edit.UserLimit = channel.UserLimit
+		if !plan.UserLimit.Equal(state.UserLimit) {
+			edit.UserLimit = int(plan.UserLimit.ValueInt64())
 		}
+		edit.NSFW = BoolPtr(false)
+		if channelType == "voice" {
+			edit.NSFW = BoolPtr(channel.NSFW)
+			if !plan.NSFW.Equal(state.NSFW) {
+				edit.NSFW = BoolPtr(plan.NSFW.ValueBool())
+			}
+		}
+	default:
+		edit.NSFW = BoolPtr(false)
 	}
 
-	if channelType != "category" && d.HasChange("category") {
-		id := d.Get("category").(string)
-		parentId = map[bool]string{true: id, false: ""}[d.Get("category").(string) != ""]
+	if channelType != "category" && !plan.Category.Equal(state.Category) {
+		edit.ParentID = plan.Category.ValueString()
 	}
-	channel, err := client.ChannelEditComplex(d.Id(), &discordgo.ChannelEdit{
-		Name:      name,
-		Position:  &position,
-		Topic:     topic,
-		NSFW:      &nsfw,
-		Bitrate:   bitRate,
-		UserLimit: userLimit,
-		ParentID:  parentId,
-	}, discordgo.WithContext(ctx))
+
+	channel, err = r.client.ChannelEditComplex(channelId, edit, discordgo.WithContext(ctx))
 	if err != nil {
-		return diag.Errorf("Failed to update channel %s: %s", d.Id(), err.Error())
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to update channel %s", channelId), err.Error())
+		return
 	}
 
-	if channelType != "category" {
-		if v, ok := d.GetOk("sync_perms_with_category"); ok && v.(bool) {
-			if channel.ParentID == "" {
-				return append(diags, diag.Errorf("Can't sync permissions with category. Channel (%s) doesn't have a category", channel.ID)...)
-			}
-			parent, err := client.Channel(channel.ParentID, discordgo.WithContext(ctx))
-			if err != nil {
-				return append(diags, diag.Errorf("Can't sync permissions with category. Channel (%s) doesn't have a category", channel.ID)...)
-			}
+	// ChannelEdit omits empty fields, so settings being unset are nulled with a
+	// separate request.
+	nulls := map[string]interface{}{}
+	if edit.Topic == "" && channel.Topic != "" {
+		nulls["topic"] = nil
+	}
+	if channelType != "category" && !plan.Category.Equal(state.Category) && edit.ParentID == "" {
+		nulls["parent_id"] = nil
+	}
+	if len(nulls) > 0 {
+		endpoint := discordgo.EndpointChannel(channelId)
+		if _, err := r.client.RequestWithBucketID(http.MethodPatch, endpoint, nulls, endpoint, discordgo.WithContext(ctx)); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to update channel %s", channelId), err.Error())
+			return
+		}
+		channel.Topic, channel.ParentID = edit.Topic, edit.ParentID
+	}
 
-			if err = syncChannelPermissions(client, ctx, parent, channel); err != nil {
-				return append(diags, diag.Errorf("Can't sync permissions with category: %s", channel.ID)...)
-			}
+	resp.Diagnostics.Append(r.update(ctx, channel, plan, state)...)
+	resp.Diagnostics.Append(r.setComputed(ctx, &plan)...)
+	resp.Diagnostics.Append(r.set(ctx, &resp.State, &plan)...)
+}
+
+func (r *channelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state channelModel
+	resp.Diagnostics.Append(r.get(ctx, req.State, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.client.ChannelDelete(state.Id.ValueString(), discordgo.WithContext(ctx)); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to delete channel %s", state.Id.ValueString()), err.Error())
+	}
+}
+
+// update applies the settings of plan that can't be passed when channel is
+// created or with ChannelEdit, and syncs the permissions of channel with its
+// category. Settings are applied when they differ from prior.
+func (r *channelResource) update(ctx context.Context, channel *discordgo.Channel, plan channelModel, prior channelModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	channelType := plan.Type.ValueString()
+
+	if channelType == "forum" || channelType == "media" {
+		if err := updateForumChannel(r.client, ctx, channel.ID, plan, prior); err != nil {
+			diags.AddError(fmt.Sprintf("Failed to update forum settings of channel %s", channel.ID), err.Error())
+			return diags
+		}
+	}
+	if err := updateChannelSettings(r.client, ctx, channel.ID, plan, prior); err != nil {
+		diags.AddError(fmt.Sprintf("Failed to update settings of channel %s", channel.ID), err.Error())
+		return diags
+	}
+
+	if channelType != "category" && plan.SyncPermsWithCategory.ValueBool() {
+		if channel.ParentID == "" {
+			diags.AddError("Can't sync permissions with category", fmt.Sprintf("Channel %s doesn't have a category", channel.ID))
+			return diags
+		}
+		parent, err := r.client.Channel(channel.ParentID, discordgo.WithContext(ctx))
+		if err != nil {
+			diags.AddError("Can't sync permissions with category", fmt.Sprintf("Failed to fetch category %s of channel %s: %s", channel.ParentID, channel.ID, err.Error()))
+			return diags
+		}
+
+		if err = syncChannelPermissions(r.client, ctx, parent, channel); err != nil {
+			diags.AddError("Can't sync permissions with category", fmt.Sprintf("Failed to sync permissions of channel %s: %s", channel.ID, err.Error()))
 		}
 	}
 
 	return diags
 }
 
-func resourceChannelDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+// setComputed fills in the values of plan that are only known once the
+// channel was created or updated. Settings that don't apply to the type of the
+// channel are null.
+func (r *channelResource) setComputed(ctx context.Context, plan *channelModel) diag.Diagnostics {
 	var diags diag.Diagnostics
-	client := m.(*Context).Session
-
-	_, err := client.ChannelDelete(d.Id(), discordgo.WithContext(ctx))
+	channel, settings, err := getChannelWithSettings(r.client, ctx, plan.Id.ValueString())
 	if err != nil {
-		return diag.Errorf("Failed to delete channel %s: %s", d.Id(), err.Error())
+		diags.AddError(fmt.Sprintf("Failed to fetch channel %s", plan.Id.ValueString()), err.Error())
+		channel, settings = &discordgo.Channel{}, &channelSettings{}
+	}
+
+	channelType := plan.Type.ValueString()
+	if plan.Bitrate.IsUnknown() {
+		plan.Bitrate = types.Int64Null()
+		if channelType == "voice" || channelType == "stage" {
+			plan.Bitrate = types.Int64Value(int64(channel.Bitrate))
+		}
+	}
+	if plan.DefaultAutoArchiveDuration.IsUnknown() {
+		plan.DefaultAutoArchiveDuration = types.Int64Null()
+		if channelType == "text" || channelType == "news" {
+			plan.DefaultAutoArchiveDuration = types.Int64Value(int64(settings.DefaultAutoArchiveDuration))
+		}
+	}
+	for i := range plan.AvailableTags {
+		if !plan.AvailableTags[i].Id.IsUnknown() {
+			continue
+		}
+		plan.AvailableTags[i].Id = types.StringNull()
+		if i < len(channel.AvailableTags) {
+			plan.AvailableTags[i].Id = types.StringValue(channel.AvailableTags[i].ID)
+		}
 	}
 
 	return diags
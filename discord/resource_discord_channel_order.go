@@ -0,0 +1,315 @@
+package discord
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/net/context"
+)
+
+type channelOrderResource struct {
+	frameworkResource
+}
+
+type channelOrderModel struct {
+	ServerId   types.String                `tfsdk:"server_id"`
+	ChannelIds []string                    `tfsdk:"channel_ids"`
+	Categories []channelOrderCategoryModel `tfsdk:"category"`
+	Id         types.String                `tfsdk:"id"`
+}
+
+type channelOrderCategoryModel struct {
+	CategoryId      types.String `tfsdk:"category_id"`
+	ChannelIds      []string     `tfsdk:"channel_ids"`
+	LockPermissions types.Bool   `tfsdk:"lock_permissions"`
+}
+
+func resourceDiscordChannelOrder() resource.Resource {
+	return &channelOrderResource{}
+}
+
+func (r *channelOrderResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel_order"
+}
+
+func (r *channelOrderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// Version 1 moved the resource from the SDK to
+		// terraform-plugin-framework.
+		Version:     1,
+		Description: "A resource to manage the layout of the channels in a server with a single request, moving channels between categories where needed. Channels that aren't listed keep their category and are put below the listed ones. The `position` and `category` of the listed channel resources should be ignored with `lifecycle { ignore_changes = [position, category] }`. Deleting this resource leaves the channels where they are.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "ID of the server.",
+			},
+			"channel_ids": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "IDs of the channels without a category, from top to bottom.",
+			},
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The ID of the server.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"category": schema.ListNestedBlock{
+				Description: "Categories from top to bottom.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"category_id": schema.StringAttribute{
+							Required:    true,
+							Description: "ID of the category.",
+						},
+						"channel_ids": schema.ListAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "IDs of the channels in the category, from top to bottom.",
+						},
+						"lock_permissions": schema.BoolAttribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(false),
+							Description: "Whether channels moved into the category get their permissions synced with it. (default `false`)",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *channelOrderResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
+
+	return map[int64]resource.StateUpgrader{0: upgradeFromSDK(resp.Schema)}
+}
+
+func (r *channelOrderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), req.ID)...)
+}
+
+// channelGroup is a list of channels that are ordered among each other:
+// the categories, the channels without a category, or the channels of one
+// category.
+type channelGroup struct {
+	parentId        string
+	categories      bool
+	channelIds      []string
+	lockPermissions bool
+}
+
+func buildChannelGroups(plan channelOrderModel) []*channelGroup {
+	groups := []*channelGroup{
+		{channelIds: plan.ChannelIds},
+		{categories: true, channelIds: make([]string, 0)},
+	}
+
+	for _, category := range plan.Categories {
+		groups[1].channelIds = append(groups[1].channelIds, category.CategoryId.ValueString())
+		groups = append(groups, &channelGroup{
+			parentId:        category.CategoryId.ValueString(),
+			channelIds:      category.ChannelIds,
+			lockPermissions: category.LockPermissions.ValueBool(),
+		})
+	}
+
+	return groups
+}
+
+// getChannelGroupMembers returns the channels currently in group, in their
+// current order.
+func getChannelGroupMembers(channels []*discordgo.Channel, group *channelGroup) []*discordgo.Channel {
+	members := make([]*discordgo.Channel, 0)
+	for _, c := range channels {
+		isCategory := c.Type == discordgo.ChannelTypeGuildCategory
+		if isCategory == group.categories && c.ParentID == group.parentId {
+			members = append(members, c)
+		}
+	}
+	sortChannels(members)
+
+	return members
+}
+
+func (r *channelOrderResource) applyChannelOrder(ctx context.Context, plan channelOrderModel) error {
+	serverId := plan.ServerId.ValueString()
+	channels, err := r.client.GuildChannels(serverId, discordgo.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	groups := buildChannelGroups(plan)
+	listed := make(map[string]bool)
+	for _, group := range groups {
+		for _, id := range group.channelIds {
+			channel := findChannelById(channels, id)
+			switch {
+			case listed[id]:
+				return fmt.Errorf("channel %s is listed more than once", id)
+			case channel == nil:
+				return fmt.Errorf("channel %s not found", id)
+			case group.categories && channel.Type != discordgo.ChannelTypeGuildCategory:
+				return fmt.Errorf("channel %s is not a category", id)
+			case !group.categories && channel.Type == discordgo.ChannelTypeGuildCategory:
+				return fmt.Errorf("category %s can't be put into a category", id)
+			}
+			listed[id] = true
+		}
+	}
+
+	// Only the channels that end up with another position or category are
+	// sent.
+	params := make([]map[string]interface{}, 0)
+	for _, group := range groups {
+		order := make([]*discordgo.Channel, 0)
+		for _, id := range group.channelIds {
+			order = append(order, findChannelById(channels, id))
+		}
+		for _, c := range getChannelGroupMembers(channels, group) {
+			if !listed[c.ID] {
+				order = append(order, c)
+			}
+		}
+
+		for position, c := range order {
+			if c.Position == position && c.ParentID == group.parentId {
+				continue
+			}
+
+			param := map[string]interface{}{
+				"id":       c.ID,
+				"position": position,
+			}
+			if c.ParentID != group.parentId {
+				if group.parentId == "" {
+					param["parent_id"] = nil
+				} else {
+					param["parent_id"] = group.parentId
+					param["lock_permissions"] = group.lockPermissions
+				}
+			}
+			params = append(params, param)
+		}
+	}
+	if len(params) == 0 {
+		return nil
+	}
+
+	endpoint := discordgo.EndpointGuildChannels(serverId)
+	_, err = r.client.RequestWithBucketID(http.MethodPatch, endpoint, params, endpoint, discordgo.WithContext(ctx))
+
+	return err
+}
+
+func (r *channelOrderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan channelOrderModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverId := plan.ServerId.ValueString()
+	if err := r.applyChannelOrder(ctx, plan); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to re-order channels of server %s", serverId), err.Error())
+		return
+	}
+
+	plan.Id = types.StringValue(serverId)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *channelOrderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state channelOrderModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverId := state.Id.ValueString()
+	channels, err := r.client.GuildChannels(serverId, discordgo.WithContext(ctx))
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to fetch channels of server %s", serverId), err.Error())
+		return
+	}
+
+	// Imported layouts take every channel of the server.
+	listed := make(map[string]bool)
+	lockPermissions := make(map[string]bool)
+	for _, group := range buildChannelGroups(state) {
+		for _, id := range group.channelIds {
+			listed[id] = true
+		}
+		lockPermissions[group.parentId] = group.lockPermissions
+	}
+	// getListed leaves lists that aren't set null when they are still empty.
+	getListed := func(group *channelGroup, prior []string) []string {
+		var ids []string
+		for _, c := range getChannelGroupMembers(channels, group) {
+			if len(listed) == 0 || listed[c.ID] {
+				ids = append(ids, c.ID)
+			}
+		}
+		if ids == nil && prior != nil {
+			ids = make([]string, 0)
+		}
+
+		return ids
+	}
+	priorChannelIds := make(map[string][]string)
+	for _, c := range state.Categories {
+		priorChannelIds[c.CategoryId.ValueString()] = c.ChannelIds
+	}
+
+	categories := make([]channelOrderCategoryModel, 0)
+	for _, id := range getListed(&channelGroup{categories: true}, nil) {
+		categories = append(categories, channelOrderCategoryModel{
+			CategoryId:      types.StringValue(id),
+			ChannelIds:      getListed(&channelGroup{parentId: id}, priorChannelIds[id]),
+			LockPermissions: types.BoolValue(lockPermissions[id]),
+		})
+	}
+
+	state.ServerId = types.StringValue(serverId)
+	state.ChannelIds = getListed(&channelGroup{}, state.ChannelIds)
+	state.Categories = categories
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *channelOrderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan channelOrderModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.applyChannelOrder(ctx, plan); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to re-order channels of server %s", plan.Id.ValueString()), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete leaves the channels where they are.
+func (r *channelOrderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
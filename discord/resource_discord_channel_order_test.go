@@ -0,0 +1,79 @@
+package discord
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceDiscordChannelOrder(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	if testServerID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID envvar must be set for acceptance tests")
+	}
+	name := "discord_channel_order.example"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDiscordChannelOrder(testServerID, "first", "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "server_id", testServerID),
+					resource.TestCheckResourceAttr(name, "category.#", "1"),
+					resource.TestCheckResourceAttrPair(name, "category.0.category_id", "discord_category_channel.example", "id"),
+					resource.TestCheckResourceAttr(name, "category.0.channel_ids.#", "2"),
+					resource.TestCheckResourceAttrPair(name, "category.0.channel_ids.0", "discord_text_channel.first", "id"),
+					resource.TestCheckResourceAttrPair(name, "category.0.channel_ids.1", "discord_text_channel.second", "id"),
+				),
+			},
+			{
+				Config: testAccResourceDiscordChannelOrder(testServerID, "second", "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "category.0.channel_ids.0", "discord_text_channel.second", "id"),
+					resource.TestCheckResourceAttrPair(name, "category.0.channel_ids.1", "discord_text_channel.first", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceDiscordChannelOrder(serverID, top, bottom string) string {
+	return fmt.Sprintf(`
+	resource "discord_category_channel" "example" {
+	  server_id = "%[1]s"
+	  name = "terraform-test-category"
+	}
+
+	resource "discord_text_channel" "first" {
+	  server_id = "%[1]s"
+	  name = "terraform-test-first"
+	  sync_perms_with_category = false
+	  lifecycle {
+	    ignore_changes = [position, category]
+	  }
+	}
+
+	resource "discord_text_channel" "second" {
+	  server_id = "%[1]s"
+	  name = "terraform-test-second"
+	  sync_perms_with_category = false
+	  lifecycle {
+	    ignore_changes = [position, category]
+	  }
+	}
+
+	resource "discord_channel_order" "example" {
+	  server_id = "%[1]s"
+
+	  category {
+	    category_id = discord_category_channel.example.id
+	    channel_ids = [
+	      discord_text_channel.%[2]s.id,
+	      discord_text_channel.%[3]s.id,
+	    ]
+	  }
+	}`, serverID, top, bottom)
+}
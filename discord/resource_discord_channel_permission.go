@@ -2,165 +2,252 @@ package discord
 
 import (
 	"fmt"
-	"log"
-	"strconv"
 
 	"github.com/bwmarrin/discordgo"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"golang.org/x/net/context"
 )
 
-func resourceDiscordChannelPermission() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: resourceChannelPermissionCreate,
-		ReadContext:   resourceChannelPermissionRead,
-		UpdateContext: resourceChannelPermissionUpdate,
-		DeleteContext: resourceChannelPermissionDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+type channelPermissionResource struct {
+	frameworkResource
+}
+
+type channelPermissionModel struct {
+	ChannelId   types.String `tfsdk:"channel_id"`
+	Type        types.String `tfsdk:"type"`
+	OverwriteId types.String `tfsdk:"overwrite_id"`
+	Allow       types.Int64  `tfsdk:"allow"`
+	Deny        types.Int64  `tfsdk:"deny"`
+	AllowNames  types.Set    `tfsdk:"allow_names"`
+	DenyNames   types.Set    `tfsdk:"deny_names"`
+	Id          types.String `tfsdk:"id"`
+}
 
+func resourceDiscordChannelPermission() resource.Resource {
+	return &channelPermissionResource{}
+}
+
+func (r *channelPermissionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel_permission"
+}
+
+func (r *channelPermissionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	atLeastOne := []path.Expression{
+		path.MatchRoot("allow"),
+		path.MatchRoot("deny"),
+		path.MatchRoot("allow_names"),
+		path.MatchRoot("deny_names"),
+	}
+
+	resp.Schema = schema.Schema{
+		// Version 1 moved the resource from the SDK to
+		// terraform-plugin-framework.
+		Version:     1,
 		Description: "A resource to create a permission override for a channel.",
-		Schema: map[string]*schema.Schema{
-			"channel_id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "ID of the channel for this override.",
+		Attributes: map[string]schema.Attribute{
+			"channel_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "ID of the channel for this override.",
 			},
-			"type": {
-				Type:         schema.TypeString,
-				ForceNew:     true,
-				Required:     true,
-				Description:  "Type of the override. Must be `role` or `user`.",
-				ValidateFunc: validation.StringInSlice([]string{"role", "user"}, false),
+			"type": schema.StringAttribute{
+				Required:      true,
+				Validators:    []validator.String{stringvalidator.OneOf("role", "user")},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "Type of the override. Must be `role` or `user`.",
 			},
-			"overwrite_id": {
-				ForceNew:    true,
-				Required:    true,
-				Type:        schema.TypeString,
-				Description: "ID of the user or role for this override.",
+			"overwrite_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "ID of the user or role for this override.",
 			},
-			"allow": {
-				AtLeastOneOf: []string{"allow", "deny"},
-				Optional:     true,
-				Type:         schema.TypeInt,
-				Description:  "Permission bits for the allowed permissions on this override. At least one of `allow` or `deny` must be set.",
+			"allow": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeastOneOf(atLeastOne...),
+					int64validator.ConflictsWith(path.MatchRoot("allow_names")),
+				},
+				Description: "Permission bits for the allowed permissions on this override. At least one of `allow`, `deny`, `allow_names` or `deny_names` must be set.",
 			},
-			"deny": {
-				AtLeastOneOf: []string{"allow", "deny"},
-				Optional:     true,
-				Type:         schema.TypeInt,
-				Description:  "Permission bits for the denied permissions on this override. At least one of `allow` or `deny` must be set.",
+			"deny": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeastOneOf(atLeastOne...),
+					int64validator.ConflictsWith(path.MatchRoot("deny_names")),
+				},
+				Description: "Permission bits for the denied permissions on this override. At least one of `allow`, `deny`, `allow_names` or `deny_names` must be set.",
 			},
-			"id": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "Hash of the channel ID, override ID, and type.",
+			"allow_names": getPermissionNamesAttribute("allow", "The names of the allowed permissions on this override, like `send_messages`. See the `discord_permission` data source for all names. Conflicts with `allow`."),
+			"deny_names":  getPermissionNamesAttribute("deny", "The names of the denied permissions on this override, like `send_messages`. See the `discord_permission` data source for all names. Conflicts with `deny`."),
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The ID of the override, in the format `channel_id:overwrite_id:type`.",
 			},
 		},
 	}
 }
 
-func resourceChannelPermissionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	client := m.(*Context).Session
-
-	channelId := d.Get("channel_id").(string)
-	overwriteId := d.Get("overwrite_id").(string)
-	permissionType, _ := getDiscordChannelPermissionType(d.Get("type").(string))
-	if err := client.ChannelPermissionSet(
-		channelId, overwriteId, permissionType,
-		int64(d.Get("allow").(int)),
-		int64(d.Get("deny").(int)), discordgo.WithContext(ctx)); err != nil {
-		return diag.Errorf("Failed to update channel permissions %s: %s", channelId, err.Error())
-	} else {
-		d.SetId(generateThreePartId(channelId, overwriteId, d.Get("type").(string)))
+func (r *channelPermissionResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
 
-		return diags
-	}
+	return map[int64]resource.StateUpgrader{0: upgradeFromSDK(resp.Schema)}
 }
 
-func resourceChannelPermissionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var (
-		diags          diag.Diagnostics
-		channelId      string
-		overwriteId    string
-		permissionType discordgo.PermissionOverwriteType
-	)
+// ModifyPlan plans both the allowed and the denied permission bits and names
+// with planPermissions.
+func (r *channelPermissionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config, plan channelPermissionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	client := m.(*Context).Session
+	resp.Diagnostics.Append(planPermissions(ctx, config.Allow, config.AllowNames, &plan.Allow, &plan.AllowNames)...)
+	resp.Diagnostics.Append(planPermissions(ctx, config.Deny, config.DenyNames, &plan.Deny, &plan.DenyNames)...)
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
 
-	cId, oId, pt, err := parseThreeIds(d.Id())
+func (r *channelPermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	channelId, overwriteId, permissionType, err := parseThreeIds(req.ID)
 	if err != nil {
-		log.Default().Printf("Unable to parse IDs out of the resource ID. Falling back on legacy config behavior.")
-		channelId = d.Get("channel_id").(string)
-		overwriteId = d.Get("overwrite_id").(string)
-		permissionType, _ = getDiscordChannelPermissionType(d.Get("type").(string))
-	} else {
-		channelId = cId
-		overwriteId = oId
-		permissionType, _ = getDiscordChannelPermissionType(pt)
-
-		d.Set("channel_id", channelId)
-		d.Set("overwrite_id", overwriteId)
-		d.Set("type", pt)
+		resp.Diagnostics.AddError("Failed to import channel permission", err.Error())
+		return
 	}
 
-	channel, err := client.Channel(channelId, discordgo.WithContext(ctx))
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("channel_id"), channelId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("overwrite_id"), overwriteId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), permissionType)...)
+}
+
+// setChannelPermission sets both the allowed and the denied permission bits
+// and names of m.
+func setChannelPermission(ctx context.Context, m *channelPermissionModel, allow int64, deny int64) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	m.Allow = types.Int64Value(allow)
+	m.AllowNames, d = setOfStrings(ctx, getPermissionNames(allow))
+	diags.Append(d...)
+	m.Deny = types.Int64Value(deny)
+	m.DenyNames, d = setOfStrings(ctx, getPermissionNames(deny))
+	diags.Append(d...)
+
+	return diags
+}
+
+func (r *channelPermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan channelPermissionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.update(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = types.StringValue(generateThreePartId(plan.ChannelId.ValueString(), plan.OverwriteId.ValueString(), plan.Type.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *channelPermissionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state channelPermissionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channelId := state.ChannelId.ValueString()
+	overwriteId := state.OverwriteId.ValueString()
+	permissionType, _ := getDiscordChannelPermissionType(state.Type.ValueString())
+
+	channel, err := r.client.Channel(channelId, discordgo.WithContext(ctx))
 	if err != nil {
-		return diag.Errorf("Failed to find channel %s: %s", channelId, err.Error())
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to find channel %s", channelId), err.Error())
+		return
 	}
 
 	for _, x := range channel.PermissionOverwrites {
-		if uint(x.Type) == uint(permissionType) && x.ID == overwriteId {
-			d.Set("allow", int(x.Allow))
-			d.Set("deny", int(x.Deny))
-			break
+		if x.Type == permissionType && x.ID == overwriteId {
+			// Older versions of the provider stored a hash as the ID after
+			// an update.
+			state.Id = types.StringValue(generateThreePartId(channelId, overwriteId, state.Type.ValueString()))
+			resp.Diagnostics.Append(setChannelPermission(ctx, &state, x.Allow, x.Deny)...)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			return
 		}
 	}
 
-	return diags
+	// The overwrite was removed from the channel.
+	resp.State.RemoveResource(ctx)
 }
 
-func resourceChannelPermissionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	client := m.(*Context).Session
-
-	channelId := d.Get("channel_id").(string)
-	overwriteId := d.Get("overwrite_id").(string)
-	permissionType, _ := getDiscordChannelPermissionType(d.Get("type").(string))
-
-	if err := client.ChannelPermissionSet(
-		channelId, overwriteId, permissionType,
-		int64(d.Get("allow").(int)),
-		int64(d.Get("deny").(int)), discordgo.WithContext(ctx)); err != nil {
-		return diag.Errorf("Failed to update channel permissions %s: %s", channelId, err.Error())
-	} else {
-		d.SetId(strconv.Itoa(
-			Hashcode(
-				fmt.Sprintf(
-					"%s:%s:%s", channelId, overwriteId, d.Get("type").(string),
-				),
-			),
-		),
-		)
-		return diags
+func (r *channelPermissionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan channelPermissionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.update(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func resourceChannelPermissionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+// update sets the override in plan on the channel, and sets the permission
+// names of plan from the permission bits.
+func (r *channelPermissionResource) update(ctx context.Context, plan *channelPermissionModel) diag.Diagnostics {
 	var diags diag.Diagnostics
-	client := m.(*Context).Session
 
-	channelId := d.Get("channel_id").(string)
-	overwriteId := d.Get("overwrite_id").(string)
-
-	if err := client.ChannelPermissionDelete(channelId, overwriteId, discordgo.WithContext(ctx)); err != nil {
-		return diag.Errorf("Failed to delete channel permissions %s: %s", channelId, err.Error())
-	} else {
+	channelId := plan.ChannelId.ValueString()
+	permissionType, _ := getDiscordChannelPermissionType(plan.Type.ValueString())
+	allow := plan.Allow.ValueInt64()
+	deny := plan.Deny.ValueInt64()
+	if err := r.client.ChannelPermissionSet(channelId, plan.OverwriteId.ValueString(), permissionType, allow, deny, discordgo.WithContext(ctx)); err != nil {
+		diags.AddError(fmt.Sprintf("Failed to update channel permissions %s", channelId), err.Error())
 		return diags
 	}
+
+	diags.Append(setChannelPermission(ctx, plan, allow, deny)...)
+
+	return diags
+}
+
+func (r *channelPermissionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state channelPermissionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channelId := state.ChannelId.ValueString()
+	if err := r.client.ChannelPermissionDelete(channelId, state.OverwriteId.ValueString(), discordgo.WithContext(ctx)); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to delete channel permissions %s", channelId), err.Error())
+	}
 }
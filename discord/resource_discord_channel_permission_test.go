@@ -17,8 +17,8 @@ func TestAccResourceDiscordChannelPermission(t *testing.T) {
 	}
 	name := "discord_channel_permission.example"
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceDiscordChannelPermission(testServerID, testChannelID, testRoleID),
@@ -27,6 +27,8 @@ func TestAccResourceDiscordChannelPermission(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "type", "role"),
 					resource.TestCheckResourceAttr(name, "overwrite_id", testRoleID),
 					resource.TestCheckResourceAttr(name, "allow", "1024"),
+					resource.TestCheckTypeSetElemAttr(name, "allow_names.*", "view_channel"),
+					resource.TestCheckResourceAttr(name, "deny_names.#", "0"),
 				),
 			},
 		},
@@ -0,0 +1,322 @@
+package discord
+
+import (
+	"fmt"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/net/context"
+)
+
+type channelPermissionsResource struct {
+	frameworkResource
+}
+
+type channelPermissionsModel struct {
+	ChannelId        types.String                       `tfsdk:"channel_id"`
+	SyncWithCategory types.Bool                         `tfsdk:"sync_with_category"`
+	Overwrites       []channelPermissionsOverwriteModel `tfsdk:"overwrite"`
+	Id               types.String                       `tfsdk:"id"`
+}
+
+type channelPermissionsOverwriteModel struct {
+	Type        types.String `tfsdk:"type"`
+	OverwriteId types.String `tfsdk:"overwrite_id"`
+	Allow       types.Int64  `tfsdk:"allow"`
+	Deny        types.Int64  `tfsdk:"deny"`
+}
+
+func resourceDiscordChannelPermissions() resource.Resource {
+	return &channelPermissionsResource{}
+}
+
+func (r *channelPermissionsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel_permissions"
+}
+
+func (r *channelPermissionsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// Version 1 moved the resource from the SDK to
+		// terraform-plugin-framework.
+		Version:     1,
+		Description: "A resource to manage all permission overrides of a channel. Overrides that aren't configured here are removed from the channel, so this shouldn't be used together with `discord_channel_permission` on the same channel.",
+		Attributes: map[string]schema.Attribute{
+			"channel_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "ID of the channel.",
+			},
+			"sync_with_category": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether to keep the overrides of the channel's category on the channel too. Configured overrides take precedence over the ones of the category.",
+			},
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The ID of the channel.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"overwrite": schema.SetNestedBlock{
+				Description: "Permission overrides of the channel.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Required:    true,
+							Validators:  []validator.String{stringvalidator.OneOf("role", "user")},
+							Description: "Type of the override. Must be `role` or `user`.",
+						},
+						"overwrite_id": schema.StringAttribute{
+							Required:    true,
+							Description: "ID of the user or role for this override.",
+						},
+						"allow": schema.Int64Attribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     int64default.StaticInt64(0),
+							Description: "Permission bits for the allowed permissions on this override.",
+						},
+						"deny": schema.Int64Attribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     int64default.StaticInt64(0),
+							Description: "Permission bits for the denied permissions on this override.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *channelPermissionsResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
+
+	return map[int64]resource.StateUpgrader{0: upgradeFromSDK(resp.Schema)}
+}
+
+func (r *channelPermissionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("channel_id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("sync_with_category"), false)...)
+}
+
+// getCategoryPermissionOverwrites returns the overrides of the category of
+// channel by the ID of the user or role they apply to.
+func getCategoryPermissionOverwrites(ctx context.Context, client *discordgo.Session, channel *discordgo.Channel) (map[string]*discordgo.PermissionOverwrite, error) {
+	overwrites := make(map[string]*discordgo.PermissionOverwrite)
+	if channel.ParentID == "" {
+		return overwrites, nil
+	}
+
+	parent, err := client.Channel(channel.ParentID, discordgo.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range parent.PermissionOverwrites {
+		overwrites[p.ID] = p
+	}
+
+	return overwrites, nil
+}
+
+// buildChannelPermissionOverwrites returns the overrides the channel should
+// end up with by the ID of the user or role they apply to.
+func buildChannelPermissionOverwrites(ctx context.Context, client *discordgo.Session, plan channelPermissionsModel, channel *discordgo.Channel) (map[string]*discordgo.PermissionOverwrite, error) {
+	overwrites := make(map[string]*discordgo.PermissionOverwrite)
+	if plan.SyncWithCategory.ValueBool() {
+		if channel.ParentID == "" {
+			return nil, fmt.Errorf("channel %s doesn't have a category to sync with", channel.ID)
+		}
+
+		category, err := getCategoryPermissionOverwrites(ctx, client, channel)
+		if err != nil {
+			return nil, err
+		}
+		overwrites = category
+	}
+
+	for _, o := range plan.Overwrites {
+		permissionType, _ := getDiscordChannelPermissionType(o.Type.ValueString())
+		overwrites[o.OverwriteId.ValueString()] = &discordgo.PermissionOverwrite{
+			ID:    o.OverwriteId.ValueString(),
+			Type:  permissionType,
+			Allow: o.Allow.ValueInt64(),
+			Deny:  o.Deny.ValueInt64(),
+		}
+	}
+
+	return overwrites, nil
+}
+
+// setChannelPermissionOverwrites makes the overrides of channel match
+// overwrites, only touching the ones that differ.
+func setChannelPermissionOverwrites(ctx context.Context, client *discordgo.Session, channel *discordgo.Channel, overwrites map[string]*discordgo.PermissionOverwrite) error {
+	current := make(map[string]*discordgo.PermissionOverwrite)
+	for _, p := range channel.PermissionOverwrites {
+		current[p.ID] = p
+	}
+
+	for id, p := range overwrites {
+		if c, ok := current[id]; ok && c.Type == p.Type && c.Allow == p.Allow && c.Deny == p.Deny {
+			continue
+		}
+		if err := client.ChannelPermissionSet(channel.ID, id, p.Type, p.Allow, p.Deny, discordgo.WithContext(ctx)); err != nil {
+			return err
+		}
+	}
+
+	for id := range current {
+		if _, ok := overwrites[id]; ok {
+			continue
+		}
+		if err := client.ChannelPermissionDelete(channel.ID, id, discordgo.WithContext(ctx)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *channelPermissionsResource) applyChannelPermissions(ctx context.Context, plan channelPermissionsModel) error {
+	channel, err := r.client.Channel(plan.ChannelId.ValueString(), discordgo.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	overwrites, err := buildChannelPermissionOverwrites(ctx, r.client, plan, channel)
+	if err != nil {
+		return err
+	}
+
+	return setChannelPermissionOverwrites(ctx, r.client, channel, overwrites)
+}
+
+func (r *channelPermissionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan channelPermissionsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channelId := plan.ChannelId.ValueString()
+	if err := r.applyChannelPermissions(ctx, plan); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to set permissions of channel %s", channelId), err.Error())
+		return
+	}
+
+	plan.Id = types.StringValue(channelId)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *channelPermissionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state channelPermissionsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channelId := state.Id.ValueString()
+	channel, err := r.client.Channel(channelId, discordgo.WithContext(ctx))
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to fetch channel %s", channelId), err.Error())
+		return
+	}
+
+	// Overrides synced from the category are left out, unless they are also
+	// configured, so they don't show up as drift.
+	category := make(map[string]*discordgo.PermissionOverwrite)
+	if state.SyncWithCategory.ValueBool() {
+		if category, err = getCategoryPermissionOverwrites(ctx, r.client, channel); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to fetch category of channel %s", channel.ID), err.Error())
+			return
+		}
+	}
+	configured := make(map[string]bool)
+	for _, o := range state.Overwrites {
+		configured[o.OverwriteId.ValueString()] = true
+	}
+
+	overwrites := make([]channelPermissionsOverwriteModel, 0, len(channel.PermissionOverwrites))
+	for _, p := range channel.PermissionOverwrites {
+		if c, ok := category[p.ID]; ok && !configured[p.ID] && c.Type == p.Type && c.Allow == p.Allow && c.Deny == p.Deny {
+			continue
+		}
+
+		overwrites = append(overwrites, channelPermissionsOverwriteModel{
+			Type:        types.StringValue(getTextChannelPermissionType(p.Type)),
+			OverwriteId: types.StringValue(p.ID),
+			Allow:       types.Int64Value(p.Allow),
+			Deny:        types.Int64Value(p.Deny),
+		})
+	}
+
+	state.ChannelId = types.StringValue(channel.ID)
+	state.Overwrites = overwrites
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *channelPermissionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan channelPermissionsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.applyChannelPermissions(ctx, plan); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to update permissions of channel %s", plan.Id.ValueString()), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *channelPermissionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state channelPermissionsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channelId := state.Id.ValueString()
+	channel, err := r.client.Channel(channelId, discordgo.WithContext(ctx))
+	if err != nil {
+		if isNotFound(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to fetch channel %s", channelId), err.Error())
+		return
+	}
+
+	// Channels synced with their category are left with its overrides.
+	overwrites := make(map[string]*discordgo.PermissionOverwrite)
+	if state.SyncWithCategory.ValueBool() {
+		if overwrites, err = getCategoryPermissionOverwrites(ctx, r.client, channel); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to fetch category of channel %s", channel.ID), err.Error())
+			return
+		}
+	}
+
+	if err := setChannelPermissionOverwrites(ctx, r.client, channel, overwrites); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to remove permissions of channel %s", channel.ID), err.Error())
+	}
+}
@@ -0,0 +1,61 @@
+package discord
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceDiscordChannelPermissions(t *testing.T) {
+	testChannelID := os.Getenv("DISCORD_TEST_CHANNEL_ID")
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	testRoleID := os.Getenv("DISCORD_TEST_ROLE_ID")
+	if testChannelID == "" || testServerID == "" || testRoleID == "" {
+		t.Skip("DISCORD_TEST_CHANNEL_ID, DISCORD_TEST_SERVER_ID and DISCORD_TEST_ROLE_ID envvars must be set for acceptance tests")
+	}
+	name := "discord_channel_permissions.example"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDiscordChannelPermissions(testServerID, testChannelID, testRoleID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "channel_id", testChannelID),
+					resource.TestCheckResourceAttr(name, "overwrite.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "overwrite.*", map[string]string{
+						"type":         "role",
+						"overwrite_id": testServerID,
+						"deny":         "2048",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "overwrite.*", map[string]string{
+						"type":         "role",
+						"overwrite_id": testRoleID,
+						"allow":        "3072",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceDiscordChannelPermissions(serverID, channelID, roleID string) string {
+	return fmt.Sprintf(`
+	resource "discord_channel_permissions" "example" {
+	  channel_id = "%[2]s"
+
+	  overwrite {
+	    type = "role"
+	    overwrite_id = "%[1]s"
+	    deny = 2048
+	  }
+
+	  overwrite {
+	    type = "role"
+	    overwrite_id = "%[3]s"
+	    allow = 3072
+	  }
+	}`, serverID, channelID, roleID)
+}
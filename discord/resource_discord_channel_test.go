@@ -0,0 +1,62 @@
+package discord
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceDiscordChannel(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	if testServerID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID envvar must be set for acceptance tests")
+	}
+	name := "discord_channel.example"
+	var channelID string
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDiscordChannel(testServerID, "text"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "server_id", testServerID),
+					resource.TestCheckResourceAttr(name, "name", "terraform-channel"),
+					resource.TestCheckResourceAttr(name, "type", "text"),
+					resource.TestCheckResourceAttr(name, "topic", "Testing channel"),
+					resource.TestCheckResourceAttr(name, "sync_perms_with_category", "false"),
+					resource.TestCheckResourceAttrWith(name, "channel_id", func(value string) error {
+						channelID = value
+						return nil
+					}),
+				),
+			},
+			{
+				Config: testAccResourceDiscordChannel(testServerID, "news"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "type", "news"),
+					resource.TestCheckResourceAttr(name, "topic", "Testing channel"),
+					resource.TestCheckResourceAttrWith(name, "channel_id", func(value string) error {
+						if value != channelID {
+							return fmt.Errorf("channel was replaced instead of converted: %s != %s", value, channelID)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceDiscordChannel(serverID string, channelType string) string {
+	return fmt.Sprintf(`
+	resource "discord_channel" "example" {
+	  server_id = "%[1]s"
+	  type = "%[2]s"
+	  name = "terraform-channel"
+	  topic = "Testing channel"
+	  sync_perms_with_category = false
+	}`, serverID, channelType)
+}
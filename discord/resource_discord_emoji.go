@@ -0,0 +1,219 @@
+package discord
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/polds/imgbase64"
+	"golang.org/x/net/context"
+)
+
+type emojiResource struct {
+	frameworkResource
+}
+
+type emojiModel struct {
+	ServerId types.String `tfsdk:"server_id"`
+	Name     types.String `tfsdk:"name"`
+	Image    types.String `tfsdk:"image"`
+	Roles    types.Set    `tfsdk:"roles"`
+	Animated types.Bool   `tfsdk:"animated"`
+	Id       types.String `tfsdk:"id"`
+}
+
+func resourceDiscordEmoji() resource.Resource {
+	return &emojiResource{}
+}
+
+func (r *emojiResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_emoji"
+}
+
+func (r *emojiResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// Version 1 moved the resource from the SDK to
+		// terraform-plugin-framework.
+		Version:     1,
+		Description: "A resource to create a custom emoji in a server.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "ID of the server the emoji is in.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Validators:  []validator.String{stringvalidator.RegexMatches(regexp.MustCompile(`^[A-Za-z0-9_]{2,32}$`), "must be 2 to 32 letters, numbers or underscores")},
+				Description: "Name of the emoji.",
+			},
+			"image": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "Path to a local image file or a data URI to upload as the emoji. The image can't be changed once the emoji is created.",
+			},
+			"roles": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "IDs of the roles allowed to use the emoji. Everyone can use it when this is empty.",
+			},
+			"animated": schema.BoolAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+				Description:   "Whether the emoji is animated.",
+			},
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The ID of the emoji.",
+			},
+		},
+	}
+}
+
+func (r *emojiResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
+
+	return map[int64]resource.StateUpgrader{0: upgradeFromSDK(resp.Schema)}
+}
+
+func (r *emojiResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	serverId, emojiId, err := parseTwoIds(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import emoji", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), emojiId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), serverId)...)
+}
+
+func getEmojiImage(image string) (string, error) {
+	if strings.HasPrefix(image, "data:") {
+		return image, nil
+	}
+
+	return imgbase64.FromLocal(image)
+}
+
+func (r *emojiResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan emojiModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverId := plan.ServerId.ValueString()
+	image, err := getEmojiImage(plan.Image.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to process %s", plan.Image.ValueString()), err.Error())
+		return
+	}
+	roles, _ := setStrings(ctx, plan.Roles)
+
+	emoji, err := r.client.GuildEmojiCreate(serverId, &discordgo.EmojiParams{
+		Name:  plan.Name.ValueString(),
+		Image: image,
+		Roles: roles,
+	}, discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to create emoji in %s", serverId), err.Error())
+		return
+	}
+
+	plan.Id = types.StringValue(emoji.ID)
+	plan.Animated = types.BoolValue(emoji.Animated)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// read refreshes m with its emoji, and reports whether the emoji still
+// exists.
+func (r *emojiResource) read(ctx context.Context, m *emojiModel) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	emoji, err := r.client.GuildEmoji(m.ServerId.ValueString(), m.Id.ValueString(), discordgo.WithContext(ctx))
+	if err != nil {
+		if isNotFound(err) {
+			return false, diags
+		}
+
+		diags.AddError(fmt.Sprintf("Failed to fetch emoji %s", m.Id.ValueString()), err.Error())
+		return true, diags
+	}
+
+	m.Name = types.StringValue(emoji.Name)
+	m.Roles, diags = setValue(ctx, emoji.Roles, m.Roles)
+	m.Animated = types.BoolValue(emoji.Animated)
+
+	return true, diags
+}
+
+func (r *emojiResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state emojiModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, diags := r.read(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *emojiResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan emojiModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverId := plan.ServerId.ValueString()
+	roles, _ := setStrings(ctx, plan.Roles)
+	if roles == nil {
+		roles = []string{}
+	}
+
+	// EmojiParams omits empty roles, which would leave the old ones in place.
+	endpoint := discordgo.EndpointGuildEmoji(serverId, plan.Id.ValueString())
+	if _, err := r.client.RequestWithBucketID(http.MethodPatch, endpoint, map[string]interface{}{
+		"name":  plan.Name.ValueString(),
+		"roles": roles,
+	}, discordgo.EndpointGuildEmojis(serverId), discordgo.WithContext(ctx)); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to update emoji %s", plan.Id.ValueString()), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *emojiResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state emojiModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.GuildEmojiDelete(state.ServerId.ValueString(), state.Id.ValueString(), discordgo.WithContext(ctx)); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to delete emoji %s", state.Id.ValueString()), err.Error())
+	}
+}
@@ -0,0 +1,47 @@
+package discord
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceDiscordEmoji(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	if testServerID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID envvar must be set for acceptance tests")
+	}
+	name := "discord_emoji.example"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDiscordEmoji(testServerID, "terraform_emoji"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "server_id", testServerID),
+					resource.TestCheckResourceAttr(name, "name", "terraform_emoji"),
+					resource.TestCheckResourceAttr(name, "roles.#", "0"),
+					resource.TestCheckResourceAttr(name, "animated", "false"),
+				),
+			},
+			{
+				Config: testAccResourceDiscordEmoji(testServerID, "terraform_emoji_renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", "terraform_emoji_renamed"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceDiscordEmoji(serverID string, name string) string {
+	return fmt.Sprintf(`
+	resource "discord_emoji" "example" {
+	  server_id = "%[1]s"
+      name = "%[2]s"
+      image = "data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAQAAAC1HAwCAAAAC0lEQVR42mNkYAAAAAYAAjCB0C8AAAAASUVORK5CYII="
+	}`, serverID, name)
+}
@@ -0,0 +1,119 @@
+package discord
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func resourceDiscordForumChannel() resource.Resource {
+	attributes, blocks := getForumChannelSchema("")
+
+	return &channelResource{
+		channelType: "forum",
+		description: "A resource to create a forum channel.",
+		attributes:  attributes,
+		blocks:      blocks,
+	}
+}
+
+// getForumChannelSchema returns the settings of forum and media channels. The
+// suffix is appended to the descriptions of the settings only they have.
+func getForumChannelSchema(suffix string) (map[string]schema.Attribute, map[string]schema.Block) {
+	attributes := map[string]schema.Attribute{
+		"topic": schema.StringAttribute{
+			Optional:    true,
+			Description: "Post guidelines of the channel.",
+		},
+		"nsfw": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+			Description: "Whether the channel is NSFW.",
+		},
+		"default_sort_order": schema.StringAttribute{
+			Optional:    true,
+			Validators:  []validator.String{stringvalidator.OneOf("latest_activity", "creation_date")},
+			Description: "Default order posts are sorted by, either `latest_activity` or `creation_date`." + suffix,
+		},
+		"default_forum_layout": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString("not_set"),
+			Validators:  []validator.String{stringvalidator.OneOf("not_set", "list_view", "gallery_view")},
+			Description: "Default layout posts are displayed in, one of `not_set`, `list_view` or `gallery_view`." + suffix,
+		},
+		"default_thread_rate_limit_per_user": schema.Int64Attribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(0),
+			Validators:  []validator.Int64{int64validator.Between(0, 21600)},
+			Description: "Slowmode in seconds applied to new posts of the channel.",
+		},
+	}
+
+	blocks := map[string]schema.Block{
+		"available_tags": schema.ListNestedBlock{
+			Validators:  []validator.List{listvalidator.SizeAtMost(20)},
+			Description: "Tags that can be applied to posts in the channel." + suffix,
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed: true,
+						// Tags keep the ID of the tag at the same position.
+						PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+						Description:   "The ID of the tag. Tags keep the ID of the tag at the same position, so a renamed tag stays on its posts.",
+					},
+					"name": schema.StringAttribute{
+						Required:    true,
+						Description: "Name of the tag.",
+					},
+					"moderated": schema.BoolAttribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+						Description: "Whether only moderators can apply the tag.",
+					},
+					"emoji_id": schema.StringAttribute{
+						Optional:    true,
+						Description: "ID of the server's custom emoji shown on the tag.",
+					},
+					"emoji_name": schema.StringAttribute{
+						Optional:    true,
+						Description: "Unicode emoji shown on the tag.",
+					},
+				},
+			},
+		},
+		"default_reaction_emoji": schema.ListNestedBlock{
+			Validators:  []validator.List{listvalidator.SizeAtMost(1)},
+			Description: "Emoji shown in the add reaction button of posts. Exactly one of `emoji_id` or `emoji_name` must be set." + suffix,
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"emoji_id": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{stringvalidator.ExactlyOneOf(
+							path.MatchRelative().AtParent().AtName("emoji_name"),
+						)},
+						Description: "ID of the server's custom emoji.",
+					},
+					"emoji_name": schema.StringAttribute{
+						Optional:    true,
+						Description: "Unicode emoji.",
+					},
+				},
+			},
+		},
+	}
+
+	return attributes, blocks
+}
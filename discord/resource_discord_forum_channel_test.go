@@ -0,0 +1,73 @@
+package discord
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceDiscordForumChannel(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	if testServerID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID envvar must be set for acceptance tests")
+	}
+	name := "discord_forum_channel.example"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDiscordForumChannel(testServerID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "server_id", testServerID),
+					resource.TestCheckResourceAttr(name, "name", "terraform-forum-channel"),
+					resource.TestCheckResourceAttr(name, "type", "forum"),
+					resource.TestCheckResourceAttr(name, "position", "1"),
+					resource.TestCheckResourceAttrSet(name, "channel_id"),
+					resource.TestCheckResourceAttr(name, "topic", "Be nice"),
+					resource.TestCheckResourceAttr(name, "available_tags.#", "2"),
+					resource.TestCheckResourceAttr(name, "available_tags.0.name", "bug"),
+					resource.TestCheckResourceAttr(name, "available_tags.0.emoji_name", "🐛"),
+					resource.TestCheckResourceAttrSet(name, "available_tags.0.id"),
+					resource.TestCheckResourceAttr(name, "available_tags.1.name", "resolved"),
+					resource.TestCheckResourceAttr(name, "available_tags.1.moderated", "true"),
+					resource.TestCheckResourceAttrSet(name, "available_tags.1.id"),
+					resource.TestCheckResourceAttr(name, "default_reaction_emoji.0.emoji_name", "👍"),
+					resource.TestCheckResourceAttr(name, "default_sort_order", "creation_date"),
+					resource.TestCheckResourceAttr(name, "default_forum_layout", "list_view"),
+					resource.TestCheckResourceAttr(name, "default_thread_rate_limit_per_user", "60"),
+					resource.TestCheckResourceAttr(name, "sync_perms_with_category", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceDiscordForumChannel(serverID string) string {
+	return fmt.Sprintf(`
+	resource "discord_forum_channel" "example" {
+	  server_id = "%[1]s"
+      name = "terraform-forum-channel"
+      position = 1
+      topic = "Be nice"
+      sync_perms_with_category = false
+      default_sort_order = "creation_date"
+      default_forum_layout = "list_view"
+      default_thread_rate_limit_per_user = 60
+
+      available_tags {
+        name = "bug"
+        emoji_name = "🐛"
+      }
+      available_tags {
+        name = "resolved"
+        moderated = true
+      }
+
+      default_reaction_emoji {
+        emoji_name = "👍"
+      }
+	}`, serverID)
+}
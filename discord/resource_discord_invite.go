@@ -1,109 +1,160 @@
 package discord
 
 import (
+	"fmt"
+
 	"github.com/bwmarrin/discordgo"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"golang.org/x/net/context"
 )
 
-func resourceDiscordInvite() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: resourceInviteCreate,
-		ReadContext:   resourceInviteRead,
-		DeleteContext: resourceInviteDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+type inviteResource struct {
+	frameworkResource
+}
+
+type inviteModel struct {
+	ChannelId types.String `tfsdk:"channel_id"`
+	MaxAge    types.Int64  `tfsdk:"max_age"`
+	MaxUses   types.Int64  `tfsdk:"max_uses"`
+	Temporary types.Bool   `tfsdk:"temporary"`
+	Unique    types.Bool   `tfsdk:"unique"`
+	Id        types.String `tfsdk:"id"`
+	Code      types.String `tfsdk:"code"`
+}
 
+func resourceDiscordInvite() resource.Resource {
+	return &inviteResource{}
+}
+
+func (r *inviteResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_invite"
+}
+
+func (r *inviteResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// Version 1 moved the resource from the SDK to
+		// terraform-plugin-framework.
+		Version:     1,
 		Description: "A resource to create an invite for a channel.",
-		Schema: map[string]*schema.Schema{
-			"channel_id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "ID of the channel to create an invite for.",
+		Attributes: map[string]schema.Attribute{
+			"channel_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "ID of the channel to create an invite for.",
 			},
-			"max_age": {
-				Type:        schema.TypeInt,
-				ForceNew:    true,
-				Optional:    true,
-				Default:     86400,
-				Description: "Age of the invite. `0` for permanent. (default `86400`)",
+			"max_age": schema.Int64Attribute{
+				Optional:      true,
+				Computed:      true,
+				Default:       int64default.StaticInt64(86400),
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+				Description:   "Age of the invite. `0` for permanent. (default `86400`)",
 			},
-			"max_uses": {
-				Type:        schema.TypeInt,
-				ForceNew:    true,
-				Optional:    true,
-				Description: "Max number of uses for the invite. `0` (the default) for unlimited.",
+			"max_uses": schema.Int64Attribute{
+				Optional:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+				Description:   "Max number of uses for the invite. `0` (the default) for unlimited.",
 			},
-			"temporary": {
-				Type:        schema.TypeBool,
-				ForceNew:    true,
-				Optional:    true,
-				Description: "Whether the invite kicks users after they close Discord. (default `false`)",
+			"temporary": schema.BoolAttribute{
+				Optional:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.RequiresReplace()},
+				Description:   "Whether the invite kicks users after they close Discord. (default `false`)",
 			},
-			"unique": {
-				Type:        schema.TypeBool,
-				ForceNew:    true,
-				Optional:    true,
-				Description: "Whether this should create a new invite every time.",
+			"unique": schema.BoolAttribute{
+				Optional:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.RequiresReplace()},
+				Description:   "Whether this should create a new invite every time.",
 			},
-			"id": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "The invite code.",
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The invite code.",
 			},
-			"code": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "The invite code.",
+			"code": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The invite code.",
 			},
 		},
 	}
 }
 
-func resourceInviteCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	client := m.(*Context).Session
+func (r *inviteResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
 
-	channelId := d.Get("channel_id").(string)
+	return map[int64]resource.StateUpgrader{0: upgradeFromSDK(resp.Schema)}
+}
 
-	if invite, err := client.ChannelInviteCreate(channelId, discordgo.Invite{
-		MaxAge:    d.Get("max_age").(int),
-		MaxUses:   d.Get("max_uses").(int),
-		Temporary: d.Get("temporary").(bool),
-		Unique:    d.Get("unique").(bool),
-	}, discordgo.WithContext(ctx)); err != nil {
-		return diag.Errorf("Failed to create a invite: %s", err.Error())
-	} else {
-		d.SetId(invite.Code)
-		d.Set("code", invite.Code)
+func (r *inviteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *inviteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan inviteModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-		return diags
+	invite, err := r.client.ChannelInviteCreate(plan.ChannelId.ValueString(), discordgo.Invite{
+		MaxAge:    int(plan.MaxAge.ValueInt64()),
+		MaxUses:   int(plan.MaxUses.ValueInt64()),
+		Temporary: plan.Temporary.ValueBool(),
+		Unique:    plan.Unique.ValueBool(),
+	}, discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create a invite", err.Error())
+		return
 	}
+
+	plan.Id = types.StringValue(invite.Code)
+	plan.Code = types.StringValue(invite.Code)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func resourceInviteRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	client := m.(*Context).Session
+func (r *inviteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state inviteModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	if invite, err := client.Invite(d.Id(), discordgo.WithContext(ctx)); err != nil {
-		d.SetId("")
-	} else {
-		d.Set("code", invite.Code)
+	invite, err := r.client.Invite(state.Id.ValueString(), discordgo.WithContext(ctx))
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to fetch invite %s", state.Id.ValueString()), err.Error())
+		return
 	}
 
-	return diags
+	state.Code = types.StringValue(invite.Code)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called, as every attribute requires the invite to be
+// replaced.
+func (r *inviteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 }
 
-func resourceInviteDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	client := m.(*Context).Session
+func (r *inviteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state inviteModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	if _, err := client.InviteDelete(d.Id(), discordgo.WithContext(ctx)); err != nil {
-		return diag.FromErr(err)
-	} else {
-		return diags
+	if _, err := r.client.InviteDelete(state.Id.ValueString(), discordgo.WithContext(ctx)); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to delete invite %s", state.Id.ValueString()), err.Error())
 	}
 }
@@ -0,0 +1,59 @@
+package discord
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceDiscordManagedServer(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	if testServerID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID envvar must be set for acceptance tests")
+	}
+	name := "discord_managed_server.example"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDiscordManagedServer(testServerID, "invites_disabled = true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "server_id", testServerID),
+					resource.TestCheckResourceAttr(name, "invites_disabled", "true"),
+				),
+			},
+			{
+				Config: testAccResourceDiscordManagedServer(testServerID, "invites_disabled = false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "invites_disabled", "false"),
+				),
+			},
+			{
+				Config: testAccResourceDiscordManagedServer(testServerID, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(name, "invites_disabled"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceDiscordManagedServer(serverID string, settings string) string {
+	return fmt.Sprintf(`
+	data "discord_server" "example" {
+	  server_id = "%[1]s"
+	}
+
+	resource "discord_managed_server" "example" {
+	  server_id = "%[1]s"
+	  name = data.discord_server.example.name
+	  verification_level = data.discord_server.example.verification_level
+	  explicit_content_filter = data.discord_server.example.explicit_content_filter
+	  default_message_notifications = data.discord_server.example.default_message_notifications
+	  %[2]s
+	}`, serverID, settings)
+}
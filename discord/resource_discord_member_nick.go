@@ -1,143 +1,169 @@
 package discord
 
 import (
-	"log"
-	"strings"
+	"fmt"
 
 	"github.com/bwmarrin/discordgo"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"golang.org/x/net/context"
 )
 
-func resourceDiscordMemberNick() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: resourceMemberNickCreate,
-		ReadContext:   resourceMemberNickRead,
-		UpdateContext: resourceMemberNickUpdate,
-		DeleteContext: resourceMemberNickDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+type memberNickResource struct {
+	frameworkResource
+}
+
+type memberNickModel struct {
+	UserId   types.String `tfsdk:"user_id"`
+	ServerId types.String `tfsdk:"server_id"`
+	Nick     types.String `tfsdk:"nick"`
+	Id       types.String `tfsdk:"id"`
+}
+
+func resourceDiscordMemberNick() resource.Resource {
+	return &memberNickResource{}
+}
+
+func (r *memberNickResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_member_nick"
+}
 
+func (r *memberNickResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// Version 1 moved the resource from the SDK to
+		// terraform-plugin-framework.
+		Version:     1,
 		Description: "A resource to manage member nicknames for a server.",
-		Schema: map[string]*schema.Schema{
-			"user_id": {
-				Type:     schema.TypeString,
-				ForceNew: true,
-				Required: true,
+		Attributes: map[string]schema.Attribute{
+			"user_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
-			"server_id": {
-				Type:     schema.TypeString,
-				ForceNew: true,
-				Required: true,
+			"server_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
-			"nick": {
-				Type:     schema.TypeString,
+			"nick": schema.StringAttribute{
 				Required: true,
 			},
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The ID of this resource.",
+			},
 		},
 	}
 }
 
-func resourceMemberNickCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-
-	client := m.(*Context).Session
+func (r *memberNickResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
 
-	serverId := d.Get("server_id").(string)
-	userId := d.Get("user_id").(string)
+	return map[int64]resource.StateUpgrader{0: upgradeFromSDK(resp.Schema)}
+}
 
-	if _, err := client.GuildMember(serverId, userId, discordgo.WithContext(ctx)); err != nil {
-		return diag.Errorf("Could not get member %s in %s: %s", userId, serverId, err.Error())
+func (r *memberNickResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	serverId, userId, err := parseTwoIds(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import member nick", err.Error())
+		return
 	}
 
-	d.SetId(generateTwoPartId(serverId, userId))
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), serverId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), userId)...)
+}
+
+func (r *memberNickResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan memberNickModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	diags = append(diags, resourceMemberNickRead(ctx, d, m)...)
-	diags = append(diags, resourceMemberNickUpdate(ctx, d, m)...)
+	if err := r.setNick(ctx, plan); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to edit member %s", plan.UserId.ValueString()), err.Error())
+		return
+	}
 
-	return diags
+	plan.Id = types.StringValue(generateTwoPartId(plan.ServerId.ValueString(), plan.UserId.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func resourceMemberNickRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	client := m.(*Context).Session
-
-	// parse server ID and userID out of the ID:
-	var serverId, userId string
-	sId, uId, err := parseTwoIds(d.Id())
-	if err != nil {
-		log.Default().Printf("Unable to parse IDs out of the resource ID. Falling back on legacy config behavior.")
-		serverId = d.Get("server_id").(string)
-		userId = d.Get("user_id").(string)
-	} else {
-		serverId = sId
-		userId = uId
+func (r *memberNickResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state memberNickModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	member, err := client.GuildMember(serverId, userId, discordgo.WithContext(ctx))
+	serverId := state.ServerId.ValueString()
+	userId := state.UserId.ValueString()
+	member, err := r.client.GuildMember(serverId, userId, discordgo.WithContext(ctx))
 	if err != nil {
-		// If error string contains "Unknown Member", it's because the member is not in the server.
-		// This is not an error, so we just return an empty ID.
-		if strings.Contains(err.Error(), "Unknown Member") {
-			log.Default().Printf("Member %s not found in server %s. Removing from state.", userId, serverId)
-			d.SetId("")
-			return nil
+		// The member left the server, which isn't an error.
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
 		}
 
-		return diag.Errorf("Could not get member %s in %s: %s", userId, serverId, err.Error())
+		resp.Diagnostics.AddError(fmt.Sprintf("Could not get member %s in %s", userId, serverId), err.Error())
+		return
 	}
 
-	d.Set("nick", member.Nick)
-
-	return diags
+	state.Nick = types.StringValue(member.Nick)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
-func resourceMemberNickUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	client := m.(*Context).Session
-
-	serverId := d.Get("server_id").(string)
-	userId := d.Get("user_id").(string)
+func (r *memberNickResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan memberNickModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	member, err := client.GuildMember(serverId, userId, discordgo.WithContext(ctx))
-	if err != nil {
-		return diag.Errorf("Could not get member %s in %s: %s", userId, serverId, err.Error())
+	if err := r.setNick(ctx, plan); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to edit member %s", plan.UserId.ValueString()), err.Error())
+		return
 	}
 
-	nick := member.Nick
-	_, newNick := d.GetChange("nick")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
 
-	if nick == newNick.(string) {
-		return diags
+// setNick gives the member in plan its nickname, unless it already has it.
+func (r *memberNickResource) setNick(ctx context.Context, plan memberNickModel) error {
+	serverId := plan.ServerId.ValueString()
+	userId := plan.UserId.ValueString()
+	member, err := r.client.GuildMember(serverId, userId, discordgo.WithContext(ctx))
+	if err != nil {
+		return err
 	}
-
-	if _, err := client.GuildMemberEdit(serverId, userId, &discordgo.GuildMemberParams{
-		Nick: newNick.(string),
-	}, discordgo.WithContext(ctx)); err != nil {
-		return diag.Errorf("Failed to edit member %s: %s", userId, err.Error())
+	if member.Nick == plan.Nick.ValueString() {
+		return nil
 	}
 
-	return diags
-}
+	_, err = r.client.GuildMemberEdit(serverId, userId, &discordgo.GuildMemberParams{
+		Nick: plan.Nick.ValueString(),
+	}, discordgo.WithContext(ctx))
 
-func resourceMemberNickDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	client := m.(*Context).Session
-	serverId := d.Get("server_id").(string)
-	userId := d.Get("user_id").(string)
+	return err
+}
 
-	_, err := client.GuildMember(serverId, userId, discordgo.WithContext(ctx))
-	if err != nil {
-		return diag.Errorf("Could not get member %s in %s: %s", userId, serverId, err.Error())
+func (r *memberNickResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state memberNickModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if _, err := client.GuildMemberEdit(serverId, userId, &discordgo.GuildMemberParams{
+	userId := state.UserId.ValueString()
+	if _, err := r.client.GuildMemberEdit(state.ServerId.ValueString(), userId, &discordgo.GuildMemberParams{
 		Nick: "",
 	}, discordgo.WithContext(ctx)); err != nil {
-		return diag.Errorf("Failed to delete member roles %s: %s", userId, err.Error())
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to delete member nick %s", userId), err.Error())
 	}
-
-	return diags
 }
@@ -1,68 +1,83 @@
 package discord
 
 import (
-	"encoding/json"
-	"log"
-	"strings"
+	"fmt"
 
 	"github.com/bwmarrin/discordgo"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"golang.org/x/net/context"
 )
 
-type RoleSchema struct {
-	RoleId  string `json:"role_id"`
-	HasRole bool   `json:"has_role"`
+type memberRolesResource struct {
+	frameworkResource
 }
 
-func convertToRoleSchema(v interface{}) (*RoleSchema, error) {
-	var roleSchema *RoleSchema
-	j, _ := json.MarshalIndent(v, "", "    ")
-	err := json.Unmarshal(j, &roleSchema)
+type memberRolesModel struct {
+	UserId   types.String      `tfsdk:"user_id"`
+	ServerId types.String      `tfsdk:"server_id"`
+	Roles    []memberRoleModel `tfsdk:"role"`
+	Id       types.String      `tfsdk:"id"`
+}
 
-	return roleSchema, err
+type memberRoleModel struct {
+	RoleId  types.String `tfsdk:"role_id"`
+	HasRole types.Bool   `tfsdk:"has_role"`
 }
 
-func resourceDiscordMemberRoles() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: resourceMemberRolesCreate,
-		ReadContext:   resourceMemberRolesRead,
-		UpdateContext: resourceMemberRolesUpdate,
-		DeleteContext: resourceMemberRolesDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+func resourceDiscordMemberRoles() resource.Resource {
+	return &memberRolesResource{}
+}
+
+func (r *memberRolesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_member_roles"
+}
 
+func (r *memberRolesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// Version 1 moved the resource from the SDK to
+		// terraform-plugin-framework.
+		Version:     1,
 		Description: "A resource to manage member roles for a server.",
-		Schema: map[string]*schema.Schema{
-			"user_id": {
-				Type:        schema.TypeString,
-				ForceNew:    true,
-				Required:    true,
-				Description: "ID of the user to manage roles for.",
+		Attributes: map[string]schema.Attribute{
+			"user_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "ID of the user to manage roles for.",
 			},
-			"server_id": {
-				Type:        schema.TypeString,
-				ForceNew:    true,
-				Required:    true,
-				Description: "ID of the server to manage roles in.",
+			"server_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "ID of the server to manage roles in.",
 			},
-			"role": {
-				Type:        schema.TypeSet,
-				Required:    true,
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The ID of this resource.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"role": schema.SetNestedBlock{
+				Validators:  []validator.Set{setvalidator.IsRequired()},
 				Description: "Roles to manage.",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"role_id": {
-							Type:        schema.TypeString,
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"role_id": schema.StringAttribute{
 							Required:    true,
 							Description: "The role ID to manage.",
 						},
-						"has_role": {
-							Type:        schema.TypeBool,
+						"has_role": schema.BoolAttribute{
 							Optional:    true,
-							Default:     true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(true),
 							Description: "Whether the user should have the role. (default `true`)",
 						},
 					},
@@ -72,162 +87,152 @@ func resourceDiscordMemberRoles() *schema.Resource {
 	}
 }
 
-func resourceMemberRolesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
+func (r *memberRolesResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
 
-	client := m.(*Context).Session
-
-	serverId := d.Get("server_id").(string)
-	userId := d.Get("user_id").(string)
+	return map[int64]resource.StateUpgrader{0: upgradeFromSDK(resp.Schema)}
+}
 
-	if _, err := client.GuildMember(serverId, userId, discordgo.WithContext(ctx)); err != nil {
-		return diag.Errorf("Could not get member %s in %s: %s", userId, serverId, err.Error())
+func (r *memberRolesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	serverId, userId, err := parseTwoIds(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import member roles", err.Error())
+		return
 	}
 
-	d.SetId(generateTwoPartId(serverId, userId))
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), serverId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), userId)...)
+}
 
-	diags = append(diags, resourceMemberRolesRead(ctx, d, m)...)
-	diags = append(diags, resourceMemberRolesUpdate(ctx, d, m)...)
+func (r *memberRolesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan memberRolesModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	return diags
-}
+	resp.Diagnostics.Append(r.update(ctx, plan, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-func resourceMemberRolesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	client := m.(*Context).Session
+	plan.Id = types.StringValue(generateTwoPartId(plan.ServerId.ValueString(), plan.UserId.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
 
-	// parse server ID and userID out of the ID:
-	var serverId, userId string
-	sId, uId, err := parseTwoIds(d.Id())
-	if err != nil {
-		log.Default().Printf("Unable to parse IDs out of the resource ID. Falling back on legacy config behavior.")
-		serverId = d.Get("server_id").(string)
-		userId = d.Get("user_id").(string)
-	} else {
-		serverId = sId
-		userId = uId
+func (r *memberRolesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state memberRolesModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	member, err := client.GuildMember(serverId, userId, discordgo.WithContext(ctx))
+	serverId := state.ServerId.ValueString()
+	userId := state.UserId.ValueString()
+	member, err := r.client.GuildMember(serverId, userId, discordgo.WithContext(ctx))
 	if err != nil {
-		// If error string contains "Unknown Member", it's because the member is not in the server.
-		// This is not an error, so we just return an empty ID.
-		if strings.Contains(err.Error(), "Unknown Member") {
-			log.Default().Printf("Member %s not found in server %s. Removing from state.", userId, serverId)
-			d.SetId("")
-			return nil
+		// The member left the server, which isn't an error.
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
 		}
 
-		return diag.Errorf("Could not get member %s in %s: %s", userId, serverId, err.Error())
+		resp.Diagnostics.AddError(fmt.Sprintf("Could not get member %s in %s", userId, serverId), err.Error())
+		return
 	}
 
-	items := d.Get("role").(*schema.Set).List()
-	roles := make([]*RoleSchema, 0, len(items))
+	for i, role := range state.Roles {
+		state.Roles[i].HasRole = types.BoolValue(hasRole(member, role.RoleId.ValueString()))
+	}
 
-	for _, r := range items {
-		v, _ := convertToRoleSchema(r)
-		if hasRole(member, v.RoleId) {
-			roles = append(roles, &RoleSchema{RoleId: v.RoleId, HasRole: true})
-		} else {
-			roles = append(roles, &RoleSchema{RoleId: v.RoleId, HasRole: false})
-		}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *memberRolesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state memberRolesModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	d.Set("role", roles)
 
-	return diags
+	resp.Diagnostics.Append(r.update(ctx, plan, state.Roles)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func resourceMemberRolesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+// update gives the member the roles in plan it should have and takes away the
+// ones it shouldn't, along with the roles it had in prior that plan no longer
+// manages.
+func (r *memberRolesResource) update(ctx context.Context, plan memberRolesModel, prior []memberRoleModel) diag.Diagnostics {
 	var diags diag.Diagnostics
-	client := m.(*Context).Session
 
-	serverId := d.Get("server_id").(string)
-	userId := d.Get("user_id").(string)
-
-	member, err := client.GuildMember(serverId, userId, discordgo.WithContext(ctx))
+	serverId := plan.ServerId.ValueString()
+	userId := plan.UserId.ValueString()
+	member, err := r.client.GuildMember(serverId, userId, discordgo.WithContext(ctx))
 	if err != nil {
-		return diag.Errorf("Could not get member %s in %s: %s", userId, serverId, err.Error())
+		diags.AddError(fmt.Sprintf("Could not get member %s in %s", userId, serverId), err.Error())
+		return diags
 	}
 
-	oldRole, newRole := d.GetChange("role")
-	oldItems := oldRole.(*schema.Set).List()
-	items := newRole.(*schema.Set).List()
-
 	roles := member.Roles
-
-	for _, r := range items {
-		v, _ := convertToRoleSchema(r)
-		memberHasRole := hasRole(member, v.RoleId)
-		// If it's supposed to have the role, and it does, continue
-		if memberHasRole && v.HasRole {
-			continue
-		}
-		// If it's supposed to have the role, and it doesn't, add it
-		if v.HasRole && !memberHasRole {
-			roles = append(roles, v.RoleId)
-		}
-		// If it's not supposed to have the role, and it does, remove it
-		if !v.HasRole && memberHasRole {
-			roles = removeRoleById(roles, v.RoleId)
+	managed := make(map[string]bool)
+	for _, role := range plan.Roles {
+		roleId := role.RoleId.ValueString()
+		managed[roleId] = true
+
+		memberHasRole := hasRole(member, roleId)
+		switch {
+		case role.HasRole.ValueBool() && !memberHasRole:
+			roles = append(roles, roleId)
+		case !role.HasRole.ValueBool() && memberHasRole:
+			roles = removeRoleById(roles, roleId)
 		}
 	}
-
-	// If the change removed the role, and the user has it, remove it
-	for _, r := range oldItems {
-		v, _ := convertToRoleSchema(r)
-		if wasRemoved(items, v) && v.HasRole {
-			roles = removeRoleById(roles, v.RoleId)
+	for _, role := range prior {
+		if roleId := role.RoleId.ValueString(); !managed[roleId] && role.HasRole.ValueBool() {
+			roles = removeRoleById(roles, roleId)
 		}
 	}
 
-	if _, err := client.GuildMemberEdit(serverId, userId, &discordgo.GuildMemberParams{
+	if _, err := r.client.GuildMemberEdit(serverId, userId, &discordgo.GuildMemberParams{
 		Roles: &roles,
 	}, discordgo.WithContext(ctx)); err != nil {
-		return diag.Errorf("Failed to edit member %s: %s", userId, err.Error())
+		diags.AddError(fmt.Sprintf("Failed to edit member %s", userId), err.Error())
 	}
 
 	return diags
 }
 
-func wasRemoved(items []interface{}, v *RoleSchema) bool {
-	for _, i := range items {
-		item, _ := convertToRoleSchema(i)
-		if item.RoleId == v.RoleId {
-			return false
-		}
+func (r *memberRolesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state memberRolesModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	return true
-}
-
-func resourceMemberRolesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	client := m.(*Context).Session
-	serverId := d.Get("server_id").(string)
-	userId := d.Get("user_id").(string)
-
-	member, err := client.GuildMember(serverId, userId, discordgo.WithContext(ctx))
+	serverId := state.ServerId.ValueString()
+	userId := state.UserId.ValueString()
+	member, err := r.client.GuildMember(serverId, userId, discordgo.WithContext(ctx))
 	if err != nil {
-		return diag.Errorf("Could not get member %s in %s: %s", userId, serverId, err.Error())
+		resp.Diagnostics.AddError(fmt.Sprintf("Could not get member %s in %s", userId, serverId), err.Error())
+		return
 	}
 
-	items := d.Get("role").(*schema.Set).List()
 	roles := member.Roles
-
-	for _, r := range items {
-		v, _ := convertToRoleSchema(r)
-		hasRole := hasRole(member, v.RoleId)
-		// if it's supposed to have the role, and it does, remove it
-		if hasRole && v.HasRole {
-			roles = removeRoleById(roles, v.RoleId)
+	for _, role := range state.Roles {
+		if roleId := role.RoleId.ValueString(); role.HasRole.ValueBool() && hasRole(member, roleId) {
+			roles = removeRoleById(roles, roleId)
 		}
 	}
 
-	if _, err := client.GuildMemberEdit(serverId, userId, &discordgo.GuildMemberParams{
+	if _, err := r.client.GuildMemberEdit(serverId, userId, &discordgo.GuildMemberParams{
 		Roles: &roles,
 	}, discordgo.WithContext(ctx)); err != nil {
-		return diag.Errorf("Failed to delete member roles %s: %s", userId, err.Error())
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to delete member roles %s", userId), err.Error())
 	}
-
-	return diags
 }
@@ -1,445 +1,765 @@
 package discord
 
 import (
+	"encoding/json"
+	"fmt"
 	"strings"
 	"time"
 
 	"github.com/bwmarrin/discordgo"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"golang.org/x/net/context"
 )
 
-func resourceDiscordMessage() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: resourceMessageCreate,
-		ReadContext:   resourceMessageRead,
-		UpdateContext: resourceMessageUpdate,
-		DeleteContext: resourceMessageDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+type messageResource struct {
+	frameworkResource
+}
+
+type messageModel struct {
+	ChannelId       types.String `tfsdk:"channel_id"`
+	ServerId        types.String `tfsdk:"server_id"`
+	Author          types.String `tfsdk:"author"`
+	Content         types.String `tfsdk:"content"`
+	Timestamp       types.String `tfsdk:"timestamp"`
+	EditedTimestamp types.String `tfsdk:"edited_timestamp"`
+	TTS             types.Bool   `tfsdk:"tts"`
+	Embed           *embedModel  `tfsdk:"embed"`
+	Pinned          types.Bool   `tfsdk:"pinned"`
+	Type            types.Int64  `tfsdk:"type"`
+	Id              types.String `tfsdk:"id"`
+}
+
+type embedModel struct {
+	Title       types.String        `tfsdk:"title"`
+	Description types.String        `tfsdk:"description"`
+	URL         types.String        `tfsdk:"url"`
+	Timestamp   types.String        `tfsdk:"timestamp"`
+	Color       types.Int64         `tfsdk:"color"`
+	Footer      *embedFooterModel   `tfsdk:"footer"`
+	Image       *embedImageModel    `tfsdk:"image"`
+	Thumbnail   *embedImageModel    `tfsdk:"thumbnail"`
+	Video       *embedVideoModel    `tfsdk:"video"`
+	Provider    *embedProviderModel `tfsdk:"provider"`
+	Author      *embedAuthorModel   `tfsdk:"author"`
+	Fields      []embedFieldModel   `tfsdk:"fields"`
+}
+
+type embedFooterModel struct {
+	Text    types.String `tfsdk:"text"`
+	IconURL types.String `tfsdk:"icon_url"`
+}
 
+// embedImageModel is both the image and the thumbnail of an embed.
+type embedImageModel struct {
+	URL      types.String `tfsdk:"url"`
+	ProxyURL types.String `tfsdk:"proxy_url"`
+	Height   types.Int64  `tfsdk:"height"`
+	Width    types.Int64  `tfsdk:"width"`
+}
+
+type embedVideoModel struct {
+	URL    types.String `tfsdk:"url"`
+	Height types.Int64  `tfsdk:"height"`
+	Width  types.Int64  `tfsdk:"width"`
+}
+
+type embedProviderModel struct {
+	Name types.String `tfsdk:"name"`
+	URL  types.String `tfsdk:"url"`
+}
+
+type embedAuthorModel struct {
+	Name         types.String `tfsdk:"name"`
+	URL          types.String `tfsdk:"url"`
+	IconURL      types.String `tfsdk:"icon_url"`
+	ProxyIconURL types.String `tfsdk:"proxy_icon_url"`
+}
+
+type embedFieldModel struct {
+	Name   types.String `tfsdk:"name"`
+	Value  types.String `tfsdk:"value"`
+	Inline types.Bool   `tfsdk:"inline"`
+}
+
+func resourceDiscordMessage() resource.Resource {
+	return &messageResource{}
+}
+
+func (r *messageResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_message"
+}
+
+func (r *messageResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// Version 1 turned the single item lists of the embed into nested
+		// attributes.
+		Version:     1,
 		Description: "A resource to create a message",
-		Schema: map[string]*schema.Schema{
-			"channel_id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "ID of the channel the message will be in.",
+		Attributes: map[string]schema.Attribute{
+			"channel_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "ID of the channel the message will be in.",
+			},
+			"server_id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "ID of the server this message is in.",
 			},
-			"server_id": {
-				Type:        schema.TypeString,
+			"author": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "ID of the user who wrote the message.",
+			},
+			"content": schema.StringAttribute{
+				Optional:    true,
+				Description: "Text content of message. At least one of `content` or `embed` must be set.",
+			},
+			"timestamp": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "When the message was sent.",
+			},
+			"edited_timestamp": schema.StringAttribute{
+				Optional:    true,
 				Computed:    true,
-				Description: "ID of the server this message is in.",
+				Description: "When the message was edited.",
 			},
-			"author": {
-				Type:        schema.TypeString,
+			"tts": schema.BoolAttribute{
+				Optional:    true,
 				Computed:    true,
-				Description: "ID of the user who wrote the message.",
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether this message triggers TTS. (default `false`)",
+			},
+			"pinned": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether this message is pinned. (default `false`)",
+			},
+			"type": schema.Int64Attribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Description:   "The type of the message.",
+			},
+			"embed": getEmbedAttribute("An embed. At least one of `content` or `embed` must be set."),
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The ID of the message.",
+			},
+		},
+	}
+}
+
+// getEmbedAttribute returns the schema of an embed, shared by every resource
+// that sends messages.
+func getEmbedAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: description,
+		Attributes: map[string]schema.Attribute{
+			"title": schema.StringAttribute{
+				Optional:    true,
+				Description: "Title of the embed.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Description of the embed.",
+			},
+			"url": schema.StringAttribute{
+				Optional:    true,
+				Description: "URL of the embed.",
+			},
+			"timestamp": schema.StringAttribute{
+				Optional:    true,
+				Description: "Timestamp of the embed content.",
 			},
-			"content": {
-				AtLeastOneOf: []string{"content", "embed"},
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "Text content of message. At least one of `content` or `embed` must be set.",
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					return old == strings.TrimSuffix(new, "\r\n")
+			"color": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Color of the embed. Must be an integer color code.",
+			},
+			"footer": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Footer of the embed.",
+				Attributes: map[string]schema.Attribute{
+					"text": schema.StringAttribute{
+						Required:    true,
+						Description: "Text of the footer.",
+					},
+					"icon_url": schema.StringAttribute{
+						Optional:    true,
+						Description: "URL to an icon to be included in the footer.",
+					},
 				},
 			},
-			"timestamp": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "When the message was sent.",
+			"image":     getEmbedImageAttribute("image"),
+			"thumbnail": getEmbedImageAttribute("thumbnail"),
+			"video": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Video to be included in the embed.",
+				Attributes: map[string]schema.Attribute{
+					"url": schema.StringAttribute{
+						Required:    true,
+						Description: "URL of the video to be included in the embed.",
+					},
+					"height": schema.Int64Attribute{
+						Optional:    true,
+						Computed:    true,
+						Description: "Height of the video.",
+					},
+					"width": schema.Int64Attribute{
+						Optional:    true,
+						Computed:    true,
+						Description: "Width of the video.",
+					},
+				},
 			},
-			"edited_timestamp": {
-				Type:        schema.TypeString,
-				Computed:    true,
+			"provider": schema.SingleNestedAttribute{
 				Optional:    true,
-				Description: "When the message was edited.",
+				Description: "Provider of the embed.",
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Optional:    true,
+						Description: "Name of the provider.",
+					},
+					"url": schema.StringAttribute{
+						Optional:    true,
+						Description: "URL of the provider.",
+					},
+				},
 			},
-			"tts": {
-				Type:        schema.TypeBool,
+			"author": schema.SingleNestedAttribute{
 				Optional:    true,
-				Default:     false,
-				Description: "Whether this message triggers TTS. (default `false`)",
+				Description: "Author of the embed.",
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Optional:    true,
+						Description: "Name of the author.",
+					},
+					"url": schema.StringAttribute{
+						Optional:    true,
+						Description: "URL of the author.",
+					},
+					"icon_url": schema.StringAttribute{
+						Optional:    true,
+						Description: "URL of the author's icon.",
+					},
+					"proxy_icon_url": schema.StringAttribute{
+						Computed:    true,
+						Description: "URL to access the author's icon via Discord's proxy.",
+					},
+				},
 			},
-			"embed": {
-				AtLeastOneOf: []string{"content", "embed"},
-				Type:         schema.TypeList,
-				Optional:     true,
-				MaxItems:     1,
-				Description:  "An embed block. At least one of `content` or `embed` must be set.",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"title": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "Title of the embed.",
-						},
-						"description": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "Description of the embed.",
-						},
-						"url": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "URL of the embed.",
-						},
-						"timestamp": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "Timestamp of the embed content.",
-						},
-						"color": {
-							Type:        schema.TypeInt,
-							Optional:    true,
-							Description: "Color of the embed. Must be an integer color code.",
-						},
-						"footer": {
-							Type:        schema.TypeList,
-							Optional:    true,
-							MaxItems:    1,
-							Description: "Footer of the embed.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"text": {
-										Type:        schema.TypeString,
-										Required:    true,
-										Description: "Text of the footer.",
-									},
-									"icon_url": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "URL to an icon to be included in the footer.",
-									},
-								},
-							},
-						},
-						"image": {
-							Type:        schema.TypeList,
-							Optional:    true,
-							MaxItems:    1,
-							Description: "Image to be included in the embed.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"url": {
-										Type:        schema.TypeString,
-										Required:    true,
-										Description: "URL of the image to be included in the embed.",
-									},
-									"proxy_url": {
-										Type:        schema.TypeString,
-										Computed:    true,
-										Description: "URL to access the image via Discord's proxy.",
-									},
-									"height": {
-										Type:        schema.TypeInt,
-										Optional:    true,
-										Description: "Height of the image.",
-									},
-									"width": {
-										Type:        schema.TypeInt,
-										Optional:    true,
-										Description: "Width of the image.",
-									},
-								},
-							},
-						},
-						"thumbnail": {
-							Type:        schema.TypeList,
-							Optional:    true,
-							MaxItems:    1,
-							Description: "Thumbnail to be included in the embed.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"url": {
-										Type:        schema.TypeString,
-										Required:    true,
-										Description: "URL of the thumbnail to be included in the embed.",
-									},
-									"proxy_url": {
-										Type:        schema.TypeString,
-										Computed:    true,
-										Description: "URL to access the thumbnail via Discord's proxy.",
-									},
-									"height": {
-										Type:        schema.TypeInt,
-										Optional:    true,
-										Description: "Height of the thumbnail.",
-									},
-									"width": {
-										Type:        schema.TypeInt,
-										Optional:    true,
-										Description: "Width of the thumbnail.",
-									},
-								},
-							},
-						},
-						"video": {
-							Type:        schema.TypeList,
-							Optional:    true,
-							MaxItems:    1,
-							Description: "Video to be included in the embed.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"url": {
-										Type:        schema.TypeString,
-										Required:    true,
-										Description: "URL of the video to be included in the embed.",
-									},
-									"height": {
-										Type:        schema.TypeInt,
-										Optional:    true,
-										Description: "Height of the video.",
-									},
-									"width": {
-										Type:        schema.TypeInt,
-										Optional:    true,
-										Description: "Width of the video.",
-									},
-								},
-							},
-						},
-						"provider": {
-							Type:        schema.TypeList,
-							Optional:    true,
-							MaxItems:    1,
-							Description: "Provider of the embed.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"name": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "Name of the provider.",
-									},
-									"url": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "URL of the provider.",
-									},
-								},
-							},
+			"fields": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "Fields of the embed.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "Name of the field.",
 						},
-						"author": {
-							Type:        schema.TypeList,
+						"value": schema.StringAttribute{
 							Optional:    true,
-							MaxItems:    1,
-							Description: "Author of the embed.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"name": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "Name of the author.",
-									},
-									"url": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "URL of the author.",
-									},
-									"icon_url": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "URL of the author's icon.",
-									},
-									"proxy_icon_url": {
-										Type:        schema.TypeString,
-										Computed:    true,
-										Description: "URL to access the author's icon via Discord's proxy.",
-									},
-								},
-							},
+							Description: "Value of the field.",
 						},
-						"fields": {
-							Type:        schema.TypeList,
+						"inline": schema.BoolAttribute{
 							Optional:    true,
-							Description: "Fields of the embed.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"name": {
-										Type:        schema.TypeString,
-										Required:    true,
-										Description: "Name of the field.",
-									},
-									"value": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "Value of the field.",
-									},
-									"inline": {
-										Type:        schema.TypeBool,
-										Optional:    true,
-										Description: "Whether the field is inline.",
-									},
-								},
-							},
+							Description: "Whether the field is inline.",
 						},
 					},
 				},
 			},
-			"pinned": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Whether this message is pinned. (default `false`)",
+		},
+	}
+}
+
+// getEmbedImageAttribute returns the schema of the image or the thumbnail of
+// an embed. Discord fills in the size of the image when it isn't set.
+func getEmbedImageAttribute(kind string) schema.SingleNestedAttribute {
+	description := "Image to be included in the embed."
+	if kind == "thumbnail" {
+		description = "Thumbnail to be included in the embed."
+	}
+
+	return schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: description,
+		Attributes: map[string]schema.Attribute{
+			"url": schema.StringAttribute{
+				Required:    true,
+				Description: fmt.Sprintf("URL of the %s to be included in the embed.", kind),
 			},
-			"type": {
-				Type:        schema.TypeInt,
+			"proxy_url": schema.StringAttribute{
 				Computed:    true,
-				Description: "The type of the message.",
+				Description: fmt.Sprintf("URL to access the %s via Discord's proxy.", kind),
 			},
-			"id": {
-				Type:        schema.TypeString,
+			"height": schema.Int64Attribute{
+				Optional:    true,
 				Computed:    true,
-				Description: "The ID of the message.",
+				Description: fmt.Sprintf("Height of the %s.", kind),
+			},
+			"width": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: fmt.Sprintf("Width of the %s.", kind),
 			},
 		},
 	}
 }
 
-func resourceMessageCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	client := m.(*Context).Session
+func (r *messageResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(path.MatchRoot("content"), path.MatchRoot("embed")),
+	}
+}
+
+func (r *messageResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {StateUpgrader: upgradeMessageStateV0},
+	}
+}
 
-	channelId := d.Get("channel_id").(string)
+// messageStateV0 is the state of a message from before the embed was an
+// object, when the resource was built on the SDK.
+type messageStateV0 struct {
+	ChannelId       string          `json:"channel_id"`
+	ServerId        string          `json:"server_id"`
+	Author          string          `json:"author"`
+	Content         string          `json:"content"`
+	Timestamp       string          `json:"timestamp"`
+	EditedTimestamp string          `json:"edited_timestamp"`
+	TTS             bool            `json:"tts"`
+	Embed           []UnmappedEmbed `json:"embed"`
+	Pinned          bool            `json:"pinned"`
+	Type            int64           `json:"type"`
+	Id              string          `json:"id"`
+}
 
-	embeds := make([]*discordgo.MessageEmbed, 0, 1)
-	if v, ok := d.GetOk("embed"); ok {
-		if embed, err := buildEmbed(v.([]interface{})); err != nil {
-			return diag.Errorf("Failed to create message in %s: %s", channelId, err.Error())
-		} else {
-			embeds = append(embeds, embed)
-		}
+func upgradeMessageStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior messageStateV0
+	if err := json.Unmarshal(req.RawState.JSON, &prior); err != nil {
+		resp.Diagnostics.AddError("Failed to upgrade the state of the message", err.Error())
+		return
 	}
-	message, err := client.ChannelMessageSendComplex(channelId, &discordgo.MessageSend{
-		Content: d.Get("content").(string),
-		Embeds:  embeds,
-		TTS:     d.Get("tts").(bool),
-	}, discordgo.WithContext(ctx))
-	if err != nil {
-		return diag.Errorf("Failed to create message in %s: %s", channelId, err.Error())
+
+	state := messageModel{
+		ChannelId:       types.StringValue(prior.ChannelId),
+		ServerId:        types.StringValue(prior.ServerId),
+		Author:          types.StringValue(prior.Author),
+		Content:         stringValue(prior.Content, types.StringNull()),
+		Timestamp:       types.StringValue(prior.Timestamp),
+		EditedTimestamp: stringValue(prior.EditedTimestamp, types.StringNull()),
+		TTS:             types.BoolValue(prior.TTS),
+		Pinned:          types.BoolValue(prior.Pinned),
+		Type:            types.Int64Value(prior.Type),
+		Id:              types.StringValue(prior.Id),
+	}
+	if len(prior.Embed) > 0 {
+		state.Embed = flattenEmbed(mapEmbed(prior.Embed[0]), nil)
 	}
 
-	d.SetId(message.ID)
-	d.Set("type", int(message.Type))
-	d.Set("timestamp", message.Timestamp.Format(time.RFC3339))
-	d.Set("author", message.Author.ID)
-	if len(message.Embeds) > 0 {
-		d.Set("embed", unbuildEmbed(message.Embeds[0]))
-	} else {
-		d.Set("embed", nil)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *messageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *messageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan messageModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	if message.GuildID != "" {
-		d.Set("server_id", message.GuildID)
+
+	channelId := plan.ChannelId.ValueString()
+	message, err := r.client.ChannelMessageSendComplex(channelId, &discordgo.MessageSend{
+		Content: plan.Content.ValueString(),
+		Embeds:  buildEmbeds(plan.Embed),
+		TTS:     plan.TTS.ValueBool(),
+	}, discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to create message in %s", channelId), err.Error())
+		return
 	}
 
-	if d.Get("pinned").(bool) {
-		pinError := client.ChannelMessagePin(channelId, message.ID, discordgo.WithContext(ctx))
-		if pinError != nil {
-			diags = append(diags, diag.Errorf("Failed to pin message %s in %s: %s", message.ID, channelId, err.Error())...)
+	plan.Id = types.StringValue(message.ID)
+	plan.ServerId = types.StringValue(message.GuildID)
+	plan.Author = types.StringValue(message.Author.ID)
+	plan.Timestamp = types.StringValue(message.Timestamp.Format(time.RFC3339))
+	plan.Type = types.Int64Value(int64(message.Type))
+	setMessageComputed(&plan, message)
+
+	if plan.Pinned.ValueBool() {
+		if err := r.client.ChannelMessagePin(channelId, message.ID, discordgo.WithContext(ctx)); err != nil {
+			plan.Pinned = types.BoolValue(false)
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to pin message %s in %s", message.ID, channelId), err.Error())
 		}
 	}
 
-	return diags
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func resourceMessageRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	client := m.(*Context).Session
+func (r *messageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state messageModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	channelId := d.Get("channel_id").(string)
-	messageId := d.Id()
-	message, err := client.ChannelMessage(channelId, messageId, discordgo.WithContext(ctx))
+	channelId := state.ChannelId.ValueString()
+	messageId := state.Id.ValueString()
+	message, err := r.client.ChannelMessage(channelId, messageId, discordgo.WithContext(ctx))
 	if err != nil {
-		return diag.Errorf("Failed to fetch message %s in %s: %s", messageId, channelId, err.Error())
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to fetch message %s in %s", messageId, channelId), err.Error())
+		return
 	}
 
 	if message.GuildID != "" {
-		d.Set("server_id", message.GuildID)
+		state.ServerId = types.StringValue(message.GuildID)
+	}
+	state.Type = types.Int64Value(int64(message.Type))
+	state.TTS = types.BoolValue(message.TTS)
+	state.Timestamp = types.StringValue(message.Timestamp.Format(time.RFC3339))
+	state.Author = types.StringValue(message.Author.ID)
+	// Discord drops the line break heredocs end the content with.
+	if strings.TrimSuffix(state.Content.ValueString(), "\r\n") != message.Content {
+		state.Content = stringValue(message.Content, state.Content)
 	}
-	d.Set("type", int(message.Type))
-	d.Set("tts", message.TTS)
-	d.Set("timestamp", message.Timestamp.Format(time.RFC3339))
-	d.Set("author", message.Author.ID)
-	d.Set("content", message.Content)
-	d.Set("pinned", message.Pinned)
+	state.Pinned = types.BoolValue(message.Pinned)
 
 	if len(message.Embeds) > 0 {
-		d.Set("embed", unbuildEmbed(message.Embeds[0]))
+		state.Embed = flattenEmbed(message.Embeds[0], state.Embed)
+	} else {
+		state.Embed = nil
 	}
 	if message.EditedTimestamp != nil {
-		d.Set("edited_timestamp", message.EditedTimestamp.Format(time.RFC3339))
+		state.EditedTimestamp = types.StringValue(message.EditedTimestamp.Format(time.RFC3339))
 	}
 
-	return diags
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
-func resourceMessageUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-
-	var diags diag.Diagnostics
-	client := m.(*Context).Session
+func (r *messageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state messageModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	channelId := d.Get("channel_id").(string)
-	messageId := d.Id()
+	channelId := plan.ChannelId.ValueString()
+	messageId := plan.Id.ValueString()
 
-	var content string
-	message, err := client.ChannelMessage(channelId, messageId, discordgo.WithContext(ctx))
+	content := plan.Content.ValueString()
+	embeds := buildEmbeds(plan.Embed)
+	message, err := r.client.ChannelMessageEditComplex(&discordgo.MessageEdit{
+		ID:      messageId,
+		Channel: channelId,
+		Content: &content,
+		Embeds:  &embeds,
+	}, discordgo.WithContext(ctx))
 	if err != nil {
-		return diag.Errorf("Failed to fetch message %s in %s: %s", messageId, channelId, err.Error())
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to update message %s in %s", messageId, channelId), err.Error())
+		return
 	}
-	if d.HasChange("content") {
-		content = d.Get("content").(string)
-	} else {
-		content = message.Content
+	setMessageComputed(&plan, message)
+
+	if !plan.Pinned.Equal(state.Pinned) {
+		if plan.Pinned.ValueBool() {
+			err = r.client.ChannelMessagePin(channelId, messageId, discordgo.WithContext(ctx))
+		} else {
+			err = r.client.ChannelMessageUnpin(channelId, messageId, discordgo.WithContext(ctx))
+		}
+		if err != nil {
+			plan.Pinned = state.Pinned
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to pin or unpin message %s in %s", messageId, channelId), err.Error())
+		}
 	}
 
-	embeds := make([]*discordgo.MessageEmbed, 0, 1)
-	if d.HasChange("embed") {
-		var embed *discordgo.MessageEmbed
-		_, n := d.GetChange("embed")
-		if len(n.([]interface{})) > 0 {
-			if e, err := buildEmbed(n.([]interface{})); err != nil {
-				return diag.Errorf("Failed to edit message %s in %s: %s", messageId, channelId, err.Error())
-			} else {
-				embed = e
-			}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *messageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state messageModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channelId := state.ChannelId.ValueString()
+	messageId := state.Id.ValueString()
+	if err := r.client.ChannelMessageDelete(channelId, messageId, discordgo.WithContext(ctx)); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to delete message %s in %s", messageId, channelId), err.Error())
+	}
+}
+
+// setMessageComputed fills in the values of plan that are only known once
+// message was sent or edited.
+func setMessageComputed(plan *messageModel, message *discordgo.Message) {
+	if plan.EditedTimestamp.IsUnknown() {
+		if message.EditedTimestamp != nil {
+			plan.EditedTimestamp = types.StringValue(message.EditedTimestamp.Format(time.RFC3339))
+		} else {
+			plan.EditedTimestamp = types.StringNull()
 		}
+	}
 
-		embeds = append(embeds, embed)
+	if plan.Embed != nil {
+		embed := &discordgo.MessageEmbed{}
+		if len(message.Embeds) > 0 {
+			embed = message.Embeds[0]
+		}
+		setEmbedComputed(plan.Embed, embed)
 	}
+}
 
-	editedMessage, err := client.ChannelMessageEditComplex(&discordgo.MessageEdit{
-		ID:      messageId,
-		Channel: channelId,
-		Content: &content,
-		Embeds:  &embeds,
-	}, discordgo.WithContext(ctx))
-	if err != nil {
-		return diag.Errorf("Failed to update message %s in %s: %s", channelId, messageId, err.Error())
+func buildEmbeds(e *embedModel) []*discordgo.MessageEmbed {
+	embeds := make([]*discordgo.MessageEmbed, 0, 1)
+	if e == nil {
+		return embeds
 	}
 
-	if len(editedMessage.Embeds) > 0 {
-		d.Set("embed", unbuildEmbed(message.Embeds[0]))
-	} else {
-		d.Set("embed", nil)
+	embed := &discordgo.MessageEmbed{
+		Title:       e.Title.ValueString(),
+		Description: e.Description.ValueString(),
+		URL:         e.URL.ValueString(),
+		Timestamp:   e.Timestamp.ValueString(),
+		Color:       int(e.Color.ValueInt64()),
+	}
+	if e.Footer != nil {
+		embed.Footer = &discordgo.MessageEmbedFooter{
+			Text:    e.Footer.Text.ValueString(),
+			IconURL: e.Footer.IconURL.ValueString(),
+		}
 	}
+	if e.Image != nil {
+		embed.Image = &discordgo.MessageEmbedImage{
+			URL:    e.Image.URL.ValueString(),
+			Width:  int(e.Image.Width.ValueInt64()),
+			Height: int(e.Image.Height.ValueInt64()),
+		}
+	}
+	if e.Thumbnail != nil {
+		embed.Thumbnail = &discordgo.MessageEmbedThumbnail{
+			URL:    e.Thumbnail.URL.ValueString(),
+			Width:  int(e.Thumbnail.Width.ValueInt64()),
+			Height: int(e.Thumbnail.Height.ValueInt64()),
+		}
+	}
+	if e.Video != nil {
+		embed.Video = &discordgo.MessageEmbedVideo{
+			URL:    e.Video.URL.ValueString(),
+			Width:  int(e.Video.Width.ValueInt64()),
+			Height: int(e.Video.Height.ValueInt64()),
+		}
+	}
+	if e.Provider != nil {
+		embed.Provider = &discordgo.MessageEmbedProvider{
+			URL:  e.Provider.URL.ValueString(),
+			Name: e.Provider.Name.ValueString(),
+		}
+	}
+	if e.Author != nil {
+		embed.Author = &discordgo.MessageEmbedAuthor{
+			Name:    e.Author.Name.ValueString(),
+			URL:     e.Author.URL.ValueString(),
+			IconURL: e.Author.IconURL.ValueString(),
+		}
+	}
+	for _, field := range e.Fields {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   field.Name.ValueString(),
+			Value:  field.Value.ValueString(),
+			Inline: field.Inline.ValueBool(),
+		})
+	}
+
+	return append(embeds, embed)
+}
 
-	d.Set("edited_timestamp", editedMessage.EditedTimestamp.Format(time.RFC3339))
+// setEmbedComputed fills in the values of e that Discord computes from embed.
+func setEmbedComputed(e *embedModel, embed *discordgo.MessageEmbed) {
+	if e.Image != nil {
+		image := embed.Image
+		if image == nil {
+			image = &discordgo.MessageEmbedImage{}
+		}
+		setEmbedImageComputed(e.Image, image.ProxyURL, image.Width, image.Height)
+	}
+	if e.Thumbnail != nil {
+		thumbnail := embed.Thumbnail
+		if thumbnail == nil {
+			thumbnail = &discordgo.MessageEmbedThumbnail{}
+		}
+		setEmbedImageComputed(e.Thumbnail, thumbnail.ProxyURL, thumbnail.Width, thumbnail.Height)
+	}
+	if e.Video != nil {
+		video := embed.Video
+		if video == nil {
+			video = &discordgo.MessageEmbedVideo{}
+		}
+		if e.Video.Width.IsUnknown() {
+			e.Video.Width = types.Int64Value(int64(video.Width))
+		}
+		if e.Video.Height.IsUnknown() {
+			e.Video.Height = types.Int64Value(int64(video.Height))
+		}
+	}
+	if e.Author != nil && e.Author.ProxyIconURL.IsUnknown() {
+		var proxyIconURL string
+		if embed.Author != nil {
+			proxyIconURL = embed.Author.ProxyIconURL
+		}
+		e.Author.ProxyIconURL = types.StringValue(proxyIconURL)
+	}
+}
 
-	return diags
+func setEmbedImageComputed(image *embedImageModel, proxyURL string, width int, height int) {
+	if image.ProxyURL.IsUnknown() {
+		image.ProxyURL = types.StringValue(proxyURL)
+	}
+	if image.Width.IsUnknown() {
+		image.Width = types.Int64Value(int64(width))
+	}
+	if image.Height.IsUnknown() {
+		image.Height = types.Int64Value(int64(height))
+	}
 }
 
-func resourceMessageDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	client := m.(*Context).Session
+// flattenEmbed returns embed as it's stored in state. Values Discord leaves
+// empty stay null when they were null in prior, so they don't show up as
+// changes of values that aren't set.
+func flattenEmbed(embed *discordgo.MessageEmbed, prior *embedModel) *embedModel {
+	if prior == nil {
+		prior = &embedModel{}
+	}
 
-	channelId := d.Get("channel_id").(string)
-	messageId := d.Id()
-	if err := client.ChannelMessageDelete(channelId, messageId, discordgo.WithContext(ctx)); err != nil {
-		return diag.Errorf("Failed to delete message %s in %s: %s", messageId, channelId, err.Error())
-	} else {
-		return diags
+	e := &embedModel{
+		Title:       stringValue(embed.Title, prior.Title),
+		Description: stringValue(embed.Description, prior.Description),
+		URL:         stringValue(embed.URL, prior.URL),
+		Timestamp:   stringValue(embed.Timestamp, prior.Timestamp),
+		Color:       int64Value(embed.Color, prior.Color),
+	}
+
+	if embed.Footer != nil {
+		priorFooter := prior.Footer
+		if priorFooter == nil {
+			priorFooter = &embedFooterModel{}
+		}
+		e.Footer = &embedFooterModel{
+			Text:    types.StringValue(embed.Footer.Text),
+			IconURL: stringValue(embed.Footer.IconURL, priorFooter.IconURL),
+		}
+	}
+	if embed.Image != nil {
+		e.Image = &embedImageModel{
+			URL:      types.StringValue(embed.Image.URL),
+			ProxyURL: types.StringValue(embed.Image.ProxyURL),
+			Width:    types.Int64Value(int64(embed.Image.Width)),
+			Height:   types.Int64Value(int64(embed.Image.Height)),
+		}
+	}
+	if embed.Thumbnail != nil {
+		e.Thumbnail = &embedImageModel{
+			URL:      types.StringValue(embed.Thumbnail.URL),
+			ProxyURL: types.StringValue(embed.Thumbnail.ProxyURL),
+			Width:    types.Int64Value(int64(embed.Thumbnail.Width)),
+			Height:   types.Int64Value(int64(embed.Thumbnail.Height)),
+		}
+	}
+	if embed.Video != nil {
+		e.Video = &embedVideoModel{
+			URL:    types.StringValue(embed.Video.URL),
+			Width:  types.Int64Value(int64(embed.Video.Width)),
+			Height: types.Int64Value(int64(embed.Video.Height)),
+		}
+	}
+	if embed.Provider != nil {
+		priorProvider := prior.Provider
+		if priorProvider == nil {
+			priorProvider = &embedProviderModel{}
+		}
+		e.Provider = &embedProviderModel{
+			Name: stringValue(embed.Provider.Name, priorProvider.Name),
+			URL:  stringValue(embed.Provider.URL, priorProvider.URL),
+		}
+	}
+	if embed.Author != nil {
+		priorAuthor := prior.Author
+		if priorAuthor == nil {
+			priorAuthor = &embedAuthorModel{}
+		}
+		e.Author = &embedAuthorModel{
+			Name:         stringValue(embed.Author.Name, priorAuthor.Name),
+			URL:          stringValue(embed.Author.URL, priorAuthor.URL),
+			IconURL:      stringValue(embed.Author.IconURL, priorAuthor.IconURL),
+			ProxyIconURL: types.StringValue(embed.Author.ProxyIconURL),
+		}
 	}
+	if len(embed.Fields) > 0 || prior.Fields != nil {
+		e.Fields = make([]embedFieldModel, 0, len(embed.Fields))
+	}
+	for i, field := range embed.Fields {
+		var priorField embedFieldModel
+		if i < len(prior.Fields) {
+			priorField = prior.Fields[i]
+		}
+		e.Fields = append(e.Fields, embedFieldModel{
+			Name:   types.StringValue(field.Name),
+			Value:  stringValue(field.Value, priorField.Value),
+			Inline: boolValue(field.Inline, priorField.Inline),
+		})
+	}
+
+	return e
+}
+
+// mapEmbed turns an embed from the state of the SDK, where every part of it
+// is a list, back into an embed.
+func mapEmbed(e UnmappedEmbed) *discordgo.MessageEmbed {
+	embed := &discordgo.MessageEmbed{
+		Title:       e.Title,
+		Description: e.Description,
+		URL:         e.URL,
+		Timestamp:   e.Timestamp,
+		Color:       e.Color,
+		Fields:      e.Fields,
+	}
+	if len(e.Footer) > 0 {
+		embed.Footer = e.Footer[0]
+	}
+	if len(e.Image) > 0 {
+		embed.Image = e.Image[0]
+	}
+	if len(e.Thumbnail) > 0 {
+		embed.Thumbnail = e.Thumbnail[0]
+	}
+	if len(e.Video) > 0 {
+		embed.Video = e.Video[0]
+	}
+	if len(e.Provider) > 0 {
+		embed.Provider = e.Provider[0]
+	}
+	if len(e.Author) > 0 {
+		embed.Author = e.Author[0]
+	}
+
+	return embed
 }
@@ -15,8 +15,8 @@ func TestAccResourceDiscordMessageContent(t *testing.T) {
 	}
 	name := "discord_message.example"
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceDiscordMessageContent(testChannelID),
@@ -37,18 +37,17 @@ func TestAccResourceDiscordMessageEmbed(t *testing.T) {
 	}
 	name := "discord_message.example"
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceDiscordEmbed(testChannelID),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(name, "channel_id", testChannelID),
-					resource.TestCheckResourceAttr(name, "embed.#", "1"),
-					resource.TestCheckResourceAttr(name, "embed.0.title", "Hello, World from Terraform!"),
-					resource.TestCheckResourceAttr(name, "embed.0.description", "This is a test message from Terraform!"),
-					resource.TestCheckResourceAttr(name, "embed.0.color", "65280"),
-					resource.TestCheckResourceAttr(name, "embed.0.footer.0.text", "This is a test footer from Terraform!"),
+					resource.TestCheckResourceAttr(name, "embed.title", "Hello, World from Terraform!"),
+					resource.TestCheckResourceAttr(name, "embed.description", "This is a test message from Terraform!"),
+					resource.TestCheckResourceAttr(name, "embed.color", "65280"),
+					resource.TestCheckResourceAttr(name, "embed.footer.text", "This is a test footer from Terraform!"),
 				),
 			},
 		},
@@ -71,11 +70,11 @@ func testAccResourceDiscordEmbed(channelID string) string {
 		}
 	resource "discord_message" "example" {
       channel_id = "%[1]s"
-      embed {
+      embed = {
 			title = "Hello, World from Terraform!"
             description = "This is a test message from Terraform!"
  		   color = data.discord_color.green.dec
- 		   footer {
+ 		   footer = {
               text = "This is a test footer from Terraform!"
 		   }
 		}
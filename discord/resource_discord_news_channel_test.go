@@ -15,8 +15,8 @@ func TestAccResourceDiscordNewsChannel(t *testing.T) {
 	}
 	name := "discord_news_channel.example"
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceDiscordNewsChannel(testServerID),
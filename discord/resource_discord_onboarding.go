@@ -0,0 +1,555 @@
+package discord
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/net/context"
+)
+
+var onboardingModes = map[string]int{
+	"default":  int(discordgo.GuildOnboardingModeDefault),
+	"advanced": int(discordgo.GuildOnboardingModeAdvanced),
+}
+
+var onboardingPromptTypes = map[string]int{
+	"multiple_choice": int(discordgo.GuildOnboardingPromptTypeMultipleChoice),
+	"dropdown":        int(discordgo.GuildOnboardingPromptTypeDropdown),
+}
+
+// discordEpoch is the first millisecond of 2015, which snowflakes count from.
+const discordEpoch = 1420070400000
+
+// onboardingParams is sent instead of discordgo.GuildOnboarding, which leaves
+// out default_channel_ids when it is empty.
+type onboardingParams struct {
+	*discordgo.GuildOnboarding
+	DefaultChannelIDs []string `json:"default_channel_ids"`
+}
+
+type onboardingResource struct {
+	frameworkResource
+}
+
+type onboardingModel struct {
+	ServerId          types.String            `tfsdk:"server_id"`
+	Enabled           types.Bool              `tfsdk:"enabled"`
+	Mode              types.String            `tfsdk:"mode"`
+	DefaultChannelIds types.Set               `tfsdk:"default_channel_ids"`
+	Prompts           []onboardingPromptModel `tfsdk:"prompt"`
+	Id                types.String            `tfsdk:"id"`
+}
+
+type onboardingPromptModel struct {
+	Id           types.String            `tfsdk:"id"`
+	Type         types.String            `tfsdk:"type"`
+	Title        types.String            `tfsdk:"title"`
+	SingleSelect types.Bool              `tfsdk:"single_select"`
+	Required     types.Bool              `tfsdk:"required"`
+	InOnboarding types.Bool              `tfsdk:"in_onboarding"`
+	Options      []onboardingOptionModel `tfsdk:"option"`
+}
+
+type onboardingOptionModel struct {
+	Id          types.String `tfsdk:"id"`
+	Title       types.String `tfsdk:"title"`
+	Description types.String `tfsdk:"description"`
+	RoleIds     types.Set    `tfsdk:"role_ids"`
+	ChannelIds  types.Set    `tfsdk:"channel_ids"`
+	EmojiId     types.String `tfsdk:"emoji_id"`
+	EmojiName   types.String `tfsdk:"emoji_name"`
+}
+
+func resourceDiscordOnboarding() resource.Resource {
+	return &onboardingResource{}
+}
+
+func (r *onboardingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_onboarding"
+}
+
+func (r *onboardingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// Version 1 moved the resource from the SDK to
+		// terraform-plugin-framework.
+		Version:     1,
+		Description: "A resource to manage the onboarding of a Community server. The whole onboarding is replaced on every apply. Deleting it disables the onboarding and removes its prompts.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "ID of the server the onboarding is in.",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether new members go through the onboarding.",
+			},
+			"mode": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("default"),
+				Validators:  []validator.String{stringvalidator.OneOf("default", "advanced")},
+				Description: "Which requirements the onboarding is checked against, either `default`, which only counts default channels, or `advanced`, which also counts the channels of the prompts.",
+			},
+			"default_channel_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "IDs of the channels that members are added to automatically.",
+			},
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The ID of the server.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"prompt": schema.ListNestedBlock{
+				Validators:  []validator.List{listvalidator.SizeAtMost(15)},
+				Description: "Prompts shown during the onboarding and in the Channels & Roles tab, in order.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the prompt. Prompts keep their ID, and so the answers of members, by their title, or by their position when they're renamed.",
+						},
+						"type": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString("multiple_choice"),
+							Validators:  []validator.String{stringvalidator.OneOf("multiple_choice", "dropdown")},
+							Description: "Type of the prompt, either `multiple_choice` or `dropdown`.",
+						},
+						"title": schema.StringAttribute{
+							Required:    true,
+							Validators:  []validator.String{stringvalidator.LengthBetween(1, 100)},
+							Description: "Title of the prompt.",
+						},
+						"single_select": schema.BoolAttribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(false),
+							Description: "Whether members may only pick one option.",
+						},
+						"required": schema.BoolAttribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(false),
+							Description: "Whether members have to answer the prompt to finish the onboarding.",
+						},
+						"in_onboarding": schema.BoolAttribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(true),
+							Description: "Whether the prompt is shown during the onboarding. Otherwise it's only shown in the Channels & Roles tab.",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"option": schema.ListNestedBlock{
+							Validators:  []validator.List{listvalidator.IsRequired(), listvalidator.SizeBetween(1, 50)},
+							Description: "Options of the prompt, in order.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Computed:    true,
+										Description: "ID of the option. Options keep their ID by their title, or by their position when they're renamed.",
+									},
+									"title": schema.StringAttribute{
+										Required:    true,
+										Validators:  []validator.String{stringvalidator.LengthBetween(1, 50)},
+										Description: "Title of the option.",
+									},
+									"description": schema.StringAttribute{
+										Optional:    true,
+										Validators:  []validator.String{stringvalidator.LengthBetween(0, 100)},
+										Description: "Description of the option.",
+									},
+									"role_ids": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "IDs of the roles members get when they pick the option.",
+									},
+									"channel_ids": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "IDs of the channels members are added to when they pick the option.",
+									},
+									"emoji_id": schema.StringAttribute{
+										Optional:    true,
+										Description: "ID of the server's custom emoji shown for the option.",
+									},
+									"emoji_name": schema.StringAttribute{
+										Optional:    true,
+										Description: "Unicode emoji shown for the option.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *onboardingResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
+
+	return map[int64]resource.StateUpgrader{0: upgradeFromSDK(resp.Schema)}
+}
+
+func (r *onboardingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), req.ID)...)
+}
+
+// matchOnboardingIds returns the ID in state for each of titles, or an empty
+// string for new ones. A title takes the ID of the one with the same title in
+// state, otherwise the ID at its position if no other title took it.
+func matchOnboardingIds(oldIds []string, oldTitles []string, titles []string) []string {
+	res := make([]string, len(titles))
+	taken := make([]bool, len(oldIds))
+	for i, title := range titles {
+		for j, oldTitle := range oldTitles {
+			if !taken[j] && oldTitle == title {
+				res[i] = oldIds[j]
+				taken[j] = true
+				break
+			}
+		}
+	}
+	for i := range titles {
+		if res[i] == "" && i < len(oldIds) && !taken[i] {
+			res[i] = oldIds[i]
+			taken[i] = true
+		}
+	}
+
+	return res
+}
+
+// idValue returns id as the planned ID of a prompt or option, which is
+// unknown for new ones.
+func idValue(id string) types.String {
+	if id == "" {
+		return types.StringUnknown()
+	}
+
+	return types.StringValue(id)
+}
+
+// ModifyPlan plans the IDs of the prompts and options. The IDs in the prompt
+// list belong to whatever prompt is at their position, so inserting or
+// reordering prompts would hand a prompt the ID, and so the answers of
+// members, of its neighbour. Prompts and options are matched to the ones in
+// state by title instead, see matchOnboardingIds.
+func (r *onboardingResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state onboardingModel
+	// Prompts or options that aren't known yet keep the IDs at their
+	// position.
+	if diags := req.Plan.Get(ctx, &plan); diags.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	oldIds := make([]string, 0, len(state.Prompts))
+	oldTitles := make([]string, 0, len(state.Prompts))
+	for _, p := range state.Prompts {
+		oldIds = append(oldIds, p.Id.ValueString())
+		oldTitles = append(oldTitles, p.Title.ValueString())
+	}
+	titles := make([]string, 0, len(plan.Prompts))
+	for _, p := range plan.Prompts {
+		titles = append(titles, p.Title.ValueString())
+	}
+	ids := matchOnboardingIds(oldIds, oldTitles, titles)
+
+	for i := range plan.Prompts {
+		prompt := &plan.Prompts[i]
+		prompt.Id = idValue(ids[i])
+
+		var oldOptionIds, oldOptionTitles []string
+		for j, id := range oldIds {
+			if id != "" && id == ids[i] {
+				for _, o := range state.Prompts[j].Options {
+					oldOptionIds = append(oldOptionIds, o.Id.ValueString())
+					oldOptionTitles = append(oldOptionTitles, o.Title.ValueString())
+				}
+			}
+		}
+		optionTitles := make([]string, 0, len(prompt.Options))
+		for _, o := range prompt.Options {
+			optionTitles = append(optionTitles, o.Title.ValueString())
+		}
+		for j, id := range matchOnboardingIds(oldOptionIds, oldOptionTitles, optionTitles) {
+			prompt.Options[j].Id = idValue(id)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("prompt"), plan.Prompts)...)
+}
+
+// buildOnboardingPrompts builds the prompts of plan, with the IDs that
+// ModifyPlan planned. Discord needs an ID for every prompt, so new prompts
+// get a snowflake of the current time.
+func buildOnboardingPrompts(ctx context.Context, plan []onboardingPromptModel) []discordgo.GuildOnboardingPrompt {
+	now := uint64(time.Now().UnixMilli()-discordEpoch) << 22
+
+	prompts := make([]discordgo.GuildOnboardingPrompt, 0, len(plan))
+	for i, p := range plan {
+		options := make([]discordgo.GuildOnboardingPromptOption, 0, len(p.Options))
+		for _, option := range p.Options {
+			roleIds, _ := setStrings(ctx, option.RoleIds)
+			channelIds, _ := setStrings(ctx, option.ChannelIds)
+			options = append(options, discordgo.GuildOnboardingPromptOption{
+				ID:          option.Id.ValueString(),
+				Title:       option.Title.ValueString(),
+				Description: option.Description.ValueString(),
+				RoleIDs:     roleIds,
+				ChannelIDs:  channelIds,
+				EmojiID:     option.EmojiId.ValueString(),
+				EmojiName:   option.EmojiName.ValueString(),
+			})
+		}
+
+		id := p.Id.ValueString()
+		if id == "" {
+			id = strconv.FormatUint(now+uint64(i), 10)
+		}
+		prompts = append(prompts, discordgo.GuildOnboardingPrompt{
+			ID:           id,
+			Type:         discordgo.GuildOnboardingPromptType(onboardingPromptTypes[p.Type.ValueString()]),
+			Title:        p.Title.ValueString(),
+			SingleSelect: p.SingleSelect.ValueBool(),
+			Required:     p.Required.ValueBool(),
+			InOnboarding: p.InOnboarding.ValueBool(),
+			Options:      options,
+		})
+	}
+
+	return prompts
+}
+
+// unbuildOnboardingPrompts returns prompts as they're configured. Values that
+// Discord leaves empty stay null when they're null in the prompts of prior
+// with the same ID.
+func unbuildOnboardingPrompts(ctx context.Context, prompts []discordgo.GuildOnboardingPrompt, prior []onboardingPromptModel) ([]onboardingPromptModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	priorOptions := make(map[string]onboardingOptionModel)
+	for _, p := range prior {
+		for _, o := range p.Options {
+			priorOptions[o.Id.ValueString()] = o
+		}
+	}
+
+	res := make([]onboardingPromptModel, 0, len(prompts))
+	for _, p := range prompts {
+		options := make([]onboardingOptionModel, 0, len(p.Options))
+		for _, o := range p.Options {
+			priorOption, ok := priorOptions[o.ID]
+			if !ok {
+				priorOption = onboardingOptionModel{RoleIds: types.SetNull(types.StringType), ChannelIds: types.SetNull(types.StringType)}
+			}
+
+			var emojiId, emojiName string
+			// Custom emojis come back with their name too, which is only
+			// configured for Unicode emojis.
+			if o.Emoji != nil && o.Emoji.ID != "" {
+				emojiId = o.Emoji.ID
+			} else if o.Emoji != nil {
+				emojiName = o.Emoji.Name
+			}
+
+			roleIds, d := setValue(ctx, o.RoleIDs, priorOption.RoleIds)
+			diags.Append(d...)
+			channelIds, d := setValue(ctx, o.ChannelIDs, priorOption.ChannelIds)
+			diags.Append(d...)
+			options = append(options, onboardingOptionModel{
+				Id:          types.StringValue(o.ID),
+				Title:       types.StringValue(o.Title),
+				Description: stringValue(o.Description, priorOption.Description),
+				RoleIds:     roleIds,
+				ChannelIds:  channelIds,
+				EmojiId:     stringValue(emojiId, priorOption.EmojiId),
+				EmojiName:   stringValue(emojiName, priorOption.EmojiName),
+			})
+		}
+
+		res = append(res, onboardingPromptModel{
+			Id:           types.StringValue(p.ID),
+			Type:         types.StringValue(getTextValue(onboardingPromptTypes, int(p.Type))),
+			Title:        types.StringValue(p.Title),
+			SingleSelect: types.BoolValue(p.SingleSelect),
+			Required:     types.BoolValue(p.Required),
+			InOnboarding: types.BoolValue(p.InOnboarding),
+			Options:      options,
+		})
+	}
+
+	return res, diags
+}
+
+// read refreshes m with the onboarding of its server, and reports whether
+// the server still exists.
+func (r *onboardingResource) read(ctx context.Context, m *onboardingModel) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	serverId := m.ServerId.ValueString()
+	onboarding, err := r.client.GuildOnboarding(serverId, discordgo.WithContext(ctx))
+	if err != nil {
+		if isNotFound(err) {
+			return false, diags
+		}
+
+		diags.AddError(fmt.Sprintf("Failed to fetch onboarding of server %s", serverId), err.Error())
+		return true, diags
+	}
+
+	m.Id = types.StringValue(serverId)
+	if onboarding.Enabled != nil {
+		m.Enabled = types.BoolValue(*onboarding.Enabled)
+	}
+	if onboarding.Mode != nil {
+		m.Mode = types.StringValue(getTextValue(onboardingModes, int(*onboarding.Mode)))
+	}
+	defaultChannelIds, d := setValue(ctx, onboarding.DefaultChannelIDs, m.DefaultChannelIds)
+	diags.Append(d...)
+	m.DefaultChannelIds = defaultChannelIds
+
+	prompts := []discordgo.GuildOnboardingPrompt{}
+	if onboarding.Prompts != nil {
+		prompts = *onboarding.Prompts
+	}
+	m.Prompts, d = unbuildOnboardingPrompts(ctx, prompts, m.Prompts)
+	diags.Append(d...)
+
+	return true, diags
+}
+
+func (r *onboardingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan onboardingModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.update(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *onboardingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state onboardingModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, diags := r.read(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func putOnboarding(c *discordgo.Session, ctx context.Context, serverId string, params *onboardingParams) error {
+	endpoint := discordgo.EndpointGuildOnboarding(serverId)
+	_, err := c.RequestWithBucketID(http.MethodPut, endpoint, params, endpoint, discordgo.WithContext(ctx))
+
+	return err
+}
+
+func (r *onboardingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan onboardingModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.update(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// update replaces the onboarding with the one in plan, and refreshes plan
+// from it.
+func (r *onboardingResource) update(ctx context.Context, plan *onboardingModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	serverId := plan.ServerId.ValueString()
+	enabled := plan.Enabled.ValueBool()
+	mode := discordgo.GuildOnboardingMode(onboardingModes[plan.Mode.ValueString()])
+	prompts := buildOnboardingPrompts(ctx, plan.Prompts)
+	defaultChannelIds, _ := setStrings(ctx, plan.DefaultChannelIds)
+	if err := putOnboarding(r.client, ctx, serverId, &onboardingParams{
+		GuildOnboarding: &discordgo.GuildOnboarding{
+			Prompts: &prompts,
+			Enabled: &enabled,
+			Mode:    &mode,
+		},
+		DefaultChannelIDs: defaultChannelIds,
+	}); err != nil {
+		diags.AddError(fmt.Sprintf("Failed to update onboarding of server %s", serverId), err.Error())
+		return diags
+	}
+
+	_, readDiags := r.read(ctx, plan)
+	diags.Append(readDiags...)
+
+	return diags
+}
+
+func (r *onboardingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state onboardingModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverId := state.ServerId.ValueString()
+	enabled := false
+	prompts := make([]discordgo.GuildOnboardingPrompt, 0)
+	if err := putOnboarding(r.client, ctx, serverId, &onboardingParams{
+		GuildOnboarding: &discordgo.GuildOnboarding{
+			Prompts: &prompts,
+			Enabled: &enabled,
+		},
+		DefaultChannelIDs: []string{},
+	}); err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to delete onboarding of server %s", serverId), err.Error())
+	}
+}
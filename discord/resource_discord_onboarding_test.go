@@ -0,0 +1,67 @@
+package discord
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceDiscordOnboarding(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	testChannelID := os.Getenv("DISCORD_TEST_CHANNEL_ID")
+	testRoleID := os.Getenv("DISCORD_TEST_ROLE_ID")
+	if testServerID == "" || testChannelID == "" || testRoleID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID, DISCORD_TEST_CHANNEL_ID and DISCORD_TEST_ROLE_ID envvars must be set for acceptance tests")
+	}
+	name := "discord_onboarding.example"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDiscordOnboarding(testServerID, testChannelID, testRoleID, "Which team are you on?"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "server_id", testServerID),
+					resource.TestCheckResourceAttr(name, "enabled", "false"),
+					resource.TestCheckResourceAttr(name, "mode", "default"),
+					resource.TestCheckResourceAttr(name, "default_channel_ids.#", "1"),
+					resource.TestCheckResourceAttr(name, "prompt.#", "1"),
+					resource.TestCheckResourceAttrSet(name, "prompt.0.id"),
+					resource.TestCheckResourceAttr(name, "prompt.0.title", "Which team are you on?"),
+					resource.TestCheckResourceAttr(name, "prompt.0.option.#", "1"),
+					resource.TestCheckResourceAttrSet(name, "prompt.0.option.0.id"),
+					resource.TestCheckResourceAttr(name, "prompt.0.option.0.role_ids.#", "1"),
+					resource.TestCheckResourceAttr(name, "prompt.0.option.0.emoji_name", "👋"),
+				),
+			},
+			{
+				Config: testAccResourceDiscordOnboarding(testServerID, testChannelID, testRoleID, "Which teams are you on?"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "prompt.0.title", "Which teams are you on?"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceDiscordOnboarding(serverID string, channelID string, roleID string, title string) string {
+	return fmt.Sprintf(`
+	resource "discord_onboarding" "example" {
+	  server_id = "%[1]s"
+	  enabled = false
+	  default_channel_ids = ["%[2]s"]
+
+	  prompt {
+	    title = "%[4]s"
+
+	    option {
+	      title = "Members"
+	      role_ids = ["%[3]s"]
+	      emoji_name = "👋"
+	    }
+	  }
+	}`, serverID, channelID, roleID, title)
+}
@@ -1,248 +1,274 @@
 package discord
 
 import (
+	"fmt"
+
 	"github.com/bwmarrin/discordgo"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"golang.org/x/net/context"
 )
 
-func resourceDiscordRole() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: resourceRoleCreate,
-		ReadContext:   resourceRoleRead,
-		UpdateContext: resourceRoleUpdate,
-		DeleteContext: resourceRoleDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: resourceRoleImport,
-		},
+type roleResource struct {
+	frameworkResource
+}
+
+type roleModel struct {
+	ServerId        types.String `tfsdk:"server_id"`
+	Name            types.String `tfsdk:"name"`
+	Permissions     types.Int64  `tfsdk:"permissions"`
+	PermissionNames types.Set    `tfsdk:"permission_names"`
+	Color           types.Int64  `tfsdk:"color"`
+	Hoist           types.Bool   `tfsdk:"hoist"`
+	Mentionable     types.Bool   `tfsdk:"mentionable"`
+	Position        types.Int64  `tfsdk:"position"`
+	Managed         types.Bool   `tfsdk:"managed"`
+	Id              types.String `tfsdk:"id"`
+}
+
+func resourceDiscordRole() resource.Resource {
+	return &roleResource{}
+}
 
+func (r *roleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role"
+}
+
+func (r *roleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
 		Description: "A resource to create a role.",
-		Schema: map[string]*schema.Schema{
-			"server_id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "Which server the role will be in.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "Which server the role will be in.",
 			},
-			"name": {
-				Type:        schema.TypeString,
+			"name": schema.StringAttribute{
 				Required:    true,
-				ForceNew:    false,
 				Description: "The name of the role.",
 			},
-			"permissions": {
-				Type:        schema.TypeInt,
+			"permissions": schema.Int64Attribute{
 				Optional:    true,
-				Default:     0,
-				ForceNew:    false,
-				Description: "The permission bits of the role.",
+				Computed:    true,
+				Validators:  []validator.Int64{int64validator.ConflictsWith(path.MatchRoot("permission_names"))},
+				Description: "The permission bits of the role. Conflicts with `permission_names`. (default `0`)",
 			},
-			"color": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				ForceNew:    false,
-				Description: "The integer representation of the role color with decimal color code.",
+			"permission_names": getPermissionNamesAttribute("permissions", "The names of the permissions of the role, like `manage_messages`. See the `discord_permission` data source for all names. Conflicts with `permissions`."),
+			"color": schema.Int64Attribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Description:   "The integer representation of the role color with decimal color code. The color is left as it is when this isn't set, and `0` removes it.",
 			},
-			"hoist": {
-				Type:        schema.TypeBool,
+			"hoist": schema.BoolAttribute{
 				Optional:    true,
-				Default:     false,
-				ForceNew:    false,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
 				Description: "Whether the role should be hoisted. (default `false`)",
 			},
-			"mentionable": {
-				Type:        schema.TypeBool,
+			"mentionable": schema.BoolAttribute{
 				Optional:    true,
-				Default:     false,
-				ForceNew:    false,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
 				Description: "Whether the role should be mentionable. (default `false`)",
 			},
-			"position": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Default:      1,
-				ForceNew:     false,
-				ValidateFunc: validation.IntAtLeast(1),
-				Description:  "The position of the role. This is reverse indexed, with `@everyone` being `0`.",
+			"position": schema.Int64Attribute{
+				Optional:      true,
+				Computed:      true,
+				Validators:    []validator.Int64{int64validator.AtLeast(1)},
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Description:   "The position of the role. This is reverse indexed, with `@everyone` being `0`. The role is left where Discord puts it when this isn't set.",
 			},
-			"managed": {
-				Type:        schema.TypeBool,
-				Computed:    true,
-				Description: "Whether this role is managed by another service.",
+			"managed": schema.BoolAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+				Description:   "Whether this role is managed by another service.",
 			},
-			"id": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "The ID of the role.",
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The ID of the role.",
 			},
 		},
 	}
 }
 
-func resourceRoleImport(ctx context.Context, data *schema.ResourceData, i interface{}) ([]*schema.ResourceData, error) {
-	if serverId, roleId, err := parseTwoIds(data.Id()); err != nil {
-		return nil, err
-	} else {
-		data.SetId(roleId)
-		data.Set("server_id", serverId)
+// ModifyPlan plans the permission bits and the permission names with
+// planPermissions.
+func (r *roleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
 
-		return schema.ImportStatePassthroughContext(ctx, data, i)
+	var config, plan roleModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-}
 
-func resourceRoleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	client := m.(*Context).Session
+	resp.Diagnostics.Append(planPermissions(ctx, config.Permissions, config.PermissionNames, &plan.Permissions, &plan.PermissionNames)...)
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
 
-	serverId := d.Get("server_id").(string)
-	server, err := client.Guild(serverId)
+func (r *roleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	serverId, roleId, err := parseTwoIds(req.ID)
 	if err != nil {
-		return diag.Errorf("Server does not exist with that ID: %s", serverId)
+		resp.Diagnostics.AddError("Failed to import role", err.Error())
+		return
 	}
-	role, err := client.GuildRoleCreate(serverId, &discordgo.RoleParams{
-		Name:        d.Get("name").(string),
-		Permissions: Int64Ptr(int64(d.Get("permissions").(int))),
-		Color:       IntPtr(d.Get("color").(int)),
-		Hoist:       BoolPtr(d.Get("hoist").(bool)),
-		Mentionable: BoolPtr(d.Get("mentionable").(bool)),
-	}, discordgo.WithContext(ctx))
 
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), roleId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), serverId)...)
+}
+
+func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan roleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverId := plan.ServerId.ValueString()
+	role, err := r.client.GuildRoleCreate(serverId, buildRoleParams(plan), discordgo.WithContext(ctx))
 	if err != nil {
-		return diag.Errorf("Failed to create role for %s: %s", serverId, err.Error())
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to create role for %s", serverId), err.Error())
+		return
+	}
+	plan.Id = types.StringValue(role.ID)
+	plan.Managed = types.BoolValue(role.Managed)
+	if plan.Color.IsUnknown() {
+		plan.Color = types.Int64Value(int64(role.Color))
 	}
 
-	if newPosition, ok := d.GetOk("position"); ok {
-		var oldRole *discordgo.Role
-		for _, r := range server.Roles {
-			if r.Position == newPosition.(int) {
-				oldRole = r
-				break
-			}
-		}
+	if plan.Position.IsUnknown() {
+		plan.Position = types.Int64Value(int64(role.Position))
+	} else if err := r.moveRole(ctx, serverId, role, int(plan.Position.ValueInt64())); err != nil {
+		plan.Position = types.Int64Value(int64(role.Position))
+		resp.Diagnostics.AddError("Failed to re-order roles", err.Error())
+	}
 
-		param := []*discordgo.Role{{ID: role.ID, Position: newPosition.(int)}}
-		if oldRole != nil {
-			param = append(param, &discordgo.Role{ID: oldRole.ID, Position: role.Position})
-		}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
 
-		if _, err := client.GuildRoleReorder(serverId, param, discordgo.WithContext(ctx)); err != nil {
-			diags = append(diags, diag.Errorf("Failed to re-order roles: %s", err.Error())...)
-		} else {
-			d.Set("position", newPosition)
-		}
+func (r *roleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state roleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	d.SetId(role.ID)
-	d.Set("server_id", server.ID)
-	d.Set("managed", role.Managed)
+	role, err := getRole(ctx, r.client, state.ServerId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to fetch role %s", state.Id.ValueString()), err.Error())
+		return
+	}
 
-	return diags
+	state.Name = types.StringValue(role.Name)
+	state.Position = types.Int64Value(int64(role.Position))
+	state.Color = types.Int64Value(int64(role.Color))
+	state.Hoist = types.BoolValue(role.Hoist)
+	state.Mentionable = types.BoolValue(role.Mentionable)
+	state.Permissions = types.Int64Value(role.Permissions)
+	names, diags := types.SetValueFrom(ctx, types.StringType, getPermissionNames(role.Permissions))
+	resp.Diagnostics.Append(diags...)
+	state.PermissionNames = names
+	state.Managed = types.BoolValue(role.Managed)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
-func resourceRoleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	client := m.(*Context).Session
+func (r *roleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state roleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	role, err := getRole(ctx, client, d.Get("server_id").(string), d.Id())
+	serverId := plan.ServerId.ValueString()
+	roleId := plan.Id.ValueString()
 
-	if err != nil {
-		return diag.Errorf("Failed to fetch role %s: %s", d.Id(), err.Error())
+	if !plan.Position.Equal(state.Position) {
+		role, err := getRole(ctx, r.client, serverId, roleId)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to fetch role %s", roleId), err.Error())
+			return
+		}
+		if err := r.moveRole(ctx, serverId, role, int(plan.Position.ValueInt64())); err != nil {
+			resp.Diagnostics.AddError("Failed to re-order roles", err.Error())
+			return
+		}
 	}
 
-	d.Set("name", role.Name)
-	d.Set("position", role.Position)
-	d.Set("color", role.Color)
-	d.Set("hoist", role.Hoist)
-	d.Set("mentionable", role.Mentionable)
-	d.Set("permissions", role.Permissions)
-	d.Set("managed", role.Managed)
-
-	return diags
+	role, err := r.client.GuildRoleEdit(serverId, roleId, buildRoleParams(plan), discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to update role %s", roleId), err.Error())
+		return
+	}
+	plan.Managed = types.BoolValue(role.Managed)
 
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func resourceRoleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	client := m.(*Context).Session
+func (r *roleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state roleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	serverId := d.Get("server_id").(string)
-	server, err := client.Guild(serverId)
-	if err != nil {
-		return diag.Errorf("Failed to fetch server %s: %s", serverId, err.Error())
+	if err := r.client.GuildRoleDelete(state.ServerId.ValueString(), state.Id.ValueString(), discordgo.WithContext(ctx)); err != nil {
+		resp.Diagnostics.AddError("Failed to delete role", err.Error())
 	}
+}
 
-	roleId := d.Id()
-	role, err := getRole(ctx, client, serverId, roleId)
+// moveRole moves role to position, swapping it with the role that's there.
+func (r *roleResource) moveRole(ctx context.Context, serverId string, role *discordgo.Role, position int) error {
+	roles, err := r.client.GuildRoles(serverId, discordgo.WithContext(ctx))
 	if err != nil {
-		return diag.Errorf("Failed to fetch role %s: %s", d.Id(), err.Error())
+		return err
 	}
 
-	if d.HasChange("position") {
-		_, newPosition := d.GetChange("position")
-		var oldRole *discordgo.Role
-		for _, r := range server.Roles {
-			if r.Position == newPosition.(int) {
-				oldRole = r
-				break
-			}
-		}
-
-		param := []*discordgo.Role{{ID: role.ID, Position: newPosition.(int)}}
-		if oldRole != nil {
-			param = append(param, &discordgo.Role{ID: oldRole.ID, Position: role.Position})
-		}
-
-		if _, err := client.GuildRoleReorder(serverId, param, discordgo.WithContext(ctx)); err != nil {
-			diags = append(diags, diag.Errorf("Failed to re-order roles: %s", err.Error())...)
-		} else {
-			d.Set("position", newPosition)
+	param := []*discordgo.Role{{ID: role.ID, Position: position}}
+	for _, other := range roles {
+		if other.Position == position && other.ID != role.ID {
+			param = append(param, &discordgo.Role{ID: other.ID, Position: role.Position})
+			break
 		}
 	}
 
-	var (
-		newName        = d.Get("name").(string)
-		newColor       int
-		newHoist       = d.Get("hoist").(bool)
-		newMentionable = d.Get("mentionable").(bool)
-		newPermissions = int64(d.Get("permissions").(int))
-	)
-	if _, v := d.GetChange("color"); v.(int) > 0 {
-		newColor = v.(int)
-	} else {
-		newColor = role.Color
-	}
-
-	if role, err = client.GuildRoleEdit(serverId, roleId, &discordgo.RoleParams{
-		Name:        newName,
-		Color:       &newColor,
-		Hoist:       &newHoist,
-		Mentionable: &newMentionable,
-		Permissions: Int64Ptr(newPermissions),
-	}, discordgo.WithContext(ctx)); err != nil {
-		return diag.Errorf("Failed to update role %s: %s", d.Id(), err.Error())
-	}
-
-	d.Set("name", role.Name)
-	d.Set("position", role.Position)
-	d.Set("color", role.Color)
-	d.Set("hoist", role.Hoist)
-	d.Set("mentionable", role.Mentionable)
-	d.Set("permissions", role.Permissions)
-	d.Set("managed", role.Managed)
-
-	return diags
+	_, err = r.client.GuildRoleReorder(serverId, param, discordgo.WithContext(ctx))
+	return err
 }
 
-func resourceRoleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	client := m.(*Context).Session
-
-	serverId := d.Get("server_id")
-	if err := client.GuildRoleDelete(serverId.(string), d.Id(), discordgo.WithContext(ctx)); err != nil {
-		return diag.Errorf("Failed to delete role: %s", err.Error())
+// buildRoleParams returns the parameters of a role in plan. The color is only
+// sent when it's known, so that a role keeps its color while `color` isn't
+// set.
+func buildRoleParams(plan roleModel) *discordgo.RoleParams {
+	params := &discordgo.RoleParams{
+		Name:        plan.Name.ValueString(),
+		Permissions: Int64Ptr(plan.Permissions.ValueInt64()),
+		Hoist:       BoolPtr(plan.Hoist.ValueBool()),
+		Mentionable: BoolPtr(plan.Mentionable.ValueBool()),
+	}
+	if !plan.Color.IsUnknown() && !plan.Color.IsNull() {
+		params.Color = IntPtr(int(plan.Color.ValueInt64()))
 	}
 
-	return diags
+	return params
 }
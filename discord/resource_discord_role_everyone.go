@@ -1,89 +1,182 @@
 package discord
 
 import (
+	"fmt"
+
 	"github.com/bwmarrin/discordgo"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"golang.org/x/net/context"
 )
 
-func resourceDiscordRoleEveryone() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: resourceRoleEveryoneRead,
-		ReadContext:   resourceRoleEveryoneRead,
-		UpdateContext: resourceRoleEveryoneUpdate,
-		DeleteContext: func(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
-			return []diag.Diagnostic{{
-				Severity: diag.Warning,
-				Summary:  "Deleting the everyone role is not allowed",
-			}}
-		},
-		Importer: &schema.ResourceImporter{
-			StateContext: resourceRoleEveryoneImport,
-		},
+type roleEveryoneResource struct {
+	frameworkResource
+}
+
+type roleEveryoneModel struct {
+	ServerId        types.String `tfsdk:"server_id"`
+	Permissions     types.Int64  `tfsdk:"permissions"`
+	PermissionNames types.Set    `tfsdk:"permission_names"`
+	Id              types.String `tfsdk:"id"`
+}
+
+func resourceDiscordRoleEveryone() resource.Resource {
+	return &roleEveryoneResource{}
+}
+
+func (r *roleEveryoneResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_everyone"
+}
 
+func (r *roleEveryoneResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// Version 1 moved the resource from the SDK to
+		// terraform-plugin-framework.
+		Version:     1,
 		Description: "Resource to manage permissions for the default `@everyone` role.",
-		Schema: map[string]*schema.Schema{
-			"server_id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "Which server the role will be in.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "Which server the role will be in.",
 			},
-			"permissions": {
-				Type:        schema.TypeInt,
+			"permissions": schema.Int64Attribute{
 				Optional:    true,
-				Default:     0,
-				ForceNew:    false,
-				Description: "The permission bits of the role.",
-			},
-			"id": {
-				Type:        schema.TypeString,
 				Computed:    true,
-				Description: "The ID of the server.",
+				Validators:  []validator.Int64{int64validator.ConflictsWith(path.MatchRoot("permission_names"))},
+				Description: "The permission bits of the role. Conflicts with `permission_names`. (default `0`)",
+			},
+			"permission_names": getPermissionNamesAttribute("permissions", "The names of the permissions of the role, like `send_messages`. See the `discord_permission` data source for all names. Conflicts with `permissions`."),
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The ID of the server.",
 			},
 		},
 	}
 }
 
-func resourceRoleEveryoneImport(ctx context.Context, data *schema.ResourceData, i interface{}) ([]*schema.ResourceData, error) {
-	data.SetId(data.Id())
-	data.Set("server_id", data.Id())
+func (r *roleEveryoneResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
+
+	return map[int64]resource.StateUpgrader{0: upgradeFromSDK(resp.Schema)}
+}
+
+// ModifyPlan plans the permission bits and the permission names with
+// planPermissions.
+func (r *roleEveryoneResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config, plan roleEveryoneModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(planPermissions(ctx, config.Permissions, config.PermissionNames, &plan.Permissions, &plan.PermissionNames)...)
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
 
-	return schema.ImportStatePassthroughContext(ctx, data, i)
+func (r *roleEveryoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), req.ID)...)
 }
 
-func resourceRoleEveryoneRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+// setRoleEveryonePermissions sets both the permission bits and the permission
+// names of m.
+func setRoleEveryonePermissions(ctx context.Context, m *roleEveryoneModel, bits int64) diag.Diagnostics {
 	var diags diag.Diagnostics
-	client := m.(*Context).Session
 
-	serverId := d.Get("server_id").(string)
-	d.SetId(serverId)
+	m.Permissions = types.Int64Value(bits)
+	m.PermissionNames, diags = setOfStrings(ctx, getPermissionNames(bits))
 
-	if role, err := getRole(ctx, client, serverId, serverId); err != nil {
-		return diag.Errorf("Failed to fetch role %s: %s", d.Id(), err.Error())
-	} else {
-		d.Set("permissions", role.Permissions)
+	return diags
+}
 
-		return diags
+func (r *roleEveryoneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan roleEveryoneModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+
+	resp.Diagnostics.Append(r.update(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func resourceRoleEveryoneUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	client := m.(*Context).Session
+func (r *roleEveryoneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state roleEveryoneModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverId := state.ServerId.ValueString()
+	role, err := getRole(ctx, r.client, serverId, serverId)
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to fetch role %s", serverId), err.Error())
+		return
+	}
+
+	state.Id = types.StringValue(serverId)
+	resp.Diagnostics.Append(setRoleEveryonePermissions(ctx, &state, role.Permissions)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *roleEveryoneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan roleEveryoneModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	serverId := d.Get("server_id").(string)
-	d.SetId(serverId)
-	newPermission := int64(d.Get("permissions").(int))
+	resp.Diagnostics.Append(r.update(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
 
-	if role, err := client.GuildRoleEdit(serverId, serverId, &discordgo.RoleParams{
-		Permissions: &newPermission,
-	}, discordgo.WithContext(ctx)); err != nil {
-		return diag.Errorf("Failed to update role %s: %s", d.Id(), err.Error())
-	} else {
-		d.Set("permissions", role.Permissions)
+// update sets the permissions in plan on the everyone role, and refreshes
+// plan from the role.
+func (r *roleEveryoneResource) update(ctx context.Context, plan *roleEveryoneModel) diag.Diagnostics {
+	var diags diag.Diagnostics
 
+	serverId := plan.ServerId.ValueString()
+	permissions := plan.Permissions.ValueInt64()
+	role, err := r.client.GuildRoleEdit(serverId, serverId, &discordgo.RoleParams{
+		Permissions: &permissions,
+	}, discordgo.WithContext(ctx))
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Failed to update role %s", serverId), err.Error())
 		return diags
 	}
+
+	plan.Id = types.StringValue(serverId)
+	diags.Append(setRoleEveryonePermissions(ctx, plan, role.Permissions)...)
+
+	return diags
+}
+
+func (r *roleEveryoneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.AddWarning("Deleting the everyone role is not allowed", "")
 }
@@ -0,0 +1,227 @@
+package discord
+
+import (
+	"fmt"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/net/context"
+)
+
+type roleOrderResource struct {
+	frameworkResource
+}
+
+type roleOrderModel struct {
+	ServerId types.String `tfsdk:"server_id"`
+	RoleIds  []string     `tfsdk:"role_ids"`
+	Id       types.String `tfsdk:"id"`
+}
+
+func resourceDiscordRoleOrder() resource.Resource {
+	return &roleOrderResource{}
+}
+
+func (r *roleOrderResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_order"
+}
+
+func (r *roleOrderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// Version 1 moved the resource from the SDK to
+		// terraform-plugin-framework.
+		Version:     1,
+		Description: "A resource to manage the order of roles in a server with a single request. The listed roles are moved into the places they currently take in the hierarchy, so roles that aren't listed stay where they are. The `position` of the listed `discord_role` resources should be ignored with `lifecycle { ignore_changes = [position] }`. Deleting this resource leaves the roles where they are.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "ID of the server.",
+			},
+			"role_ids": schema.ListAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators:  []validator.List{listvalidator.SizeAtLeast(1)},
+				Description: "IDs of the roles from the top of the hierarchy down. `@everyone` is always at the bottom and can't be listed.",
+			},
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The ID of the server.",
+			},
+		},
+	}
+}
+
+func (r *roleOrderResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
+
+	return map[int64]resource.StateUpgrader{0: upgradeFromSDK(resp.Schema)}
+}
+
+func (r *roleOrderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), req.ID)...)
+}
+
+// getRoleHierarchy returns the roles of a server from the top of the
+// hierarchy down, without `@everyone`.
+func getRoleHierarchy(ctx context.Context, client *discordgo.Session, serverId string) ([]*discordgo.Role, error) {
+	roles, err := client.GuildRoles(serverId, discordgo.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	hierarchy := make([]*discordgo.Role, 0, len(roles))
+	for _, r := range roles {
+		if r.ID != serverId {
+			hierarchy = append(hierarchy, r)
+		}
+	}
+	sortRoles(hierarchy)
+
+	return hierarchy, nil
+}
+
+func (r *roleOrderResource) applyRoleOrder(ctx context.Context, plan roleOrderModel) error {
+	serverId := plan.ServerId.ValueString()
+	hierarchy, err := getRoleHierarchy(ctx, r.client, serverId)
+	if err != nil {
+		return err
+	}
+
+	listed := make(map[string]bool)
+	for _, id := range plan.RoleIds {
+		switch {
+		case id == serverId:
+			return fmt.Errorf("@everyone can't be reordered")
+		case listed[id]:
+			return fmt.Errorf("role %s is listed more than once", id)
+		case findRoleById(hierarchy, id) == nil:
+			return fmt.Errorf("role %s not found", id)
+		}
+		listed[id] = true
+	}
+
+	// The listed roles swap the positions they currently hold among each
+	// other, so roles that aren't listed are never sent and roles above the
+	// bot's highest role can stay unlisted.
+	positions := make([]int, 0, len(plan.RoleIds))
+	for _, r := range hierarchy {
+		if listed[r.ID] {
+			positions = append(positions, r.Position)
+		}
+	}
+
+	params := make([]*discordgo.Role, 0)
+	for i, id := range plan.RoleIds {
+		if r := findRoleById(hierarchy, id); r.Position != positions[i] {
+			params = append(params, &discordgo.Role{ID: id, Position: positions[i]})
+		}
+	}
+	if len(params) == 0 {
+		return nil
+	}
+
+	_, err = r.client.GuildRoleReorder(serverId, params, discordgo.WithContext(ctx))
+
+	return err
+}
+
+func (r *roleOrderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan roleOrderModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverId := plan.ServerId.ValueString()
+	if err := r.applyRoleOrder(ctx, plan); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to re-order roles of server %s", serverId), err.Error())
+		return
+	}
+
+	plan.Id = types.StringValue(serverId)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// read refreshes m with the order of its roles, and reports whether the
+// server still exists. Imported orders take every role of the server.
+func (r *roleOrderResource) read(ctx context.Context, m *roleOrderModel) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	serverId := m.ServerId.ValueString()
+	hierarchy, err := getRoleHierarchy(ctx, r.client, serverId)
+	if err != nil {
+		if isNotFound(err) {
+			return false, diags
+		}
+
+		diags.AddError(fmt.Sprintf("Failed to fetch roles of server %s", serverId), err.Error())
+		return true, diags
+	}
+
+	listed := make(map[string]bool)
+	for _, id := range m.RoleIds {
+		listed[id] = true
+	}
+
+	roleIds := make([]string, 0, len(hierarchy))
+	for _, r := range hierarchy {
+		if len(listed) == 0 || listed[r.ID] {
+			roleIds = append(roleIds, r.ID)
+		}
+	}
+
+	m.Id = types.StringValue(serverId)
+	m.RoleIds = roleIds
+
+	return true, diags
+}
+
+func (r *roleOrderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state roleOrderModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, diags := r.read(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *roleOrderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan roleOrderModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.applyRoleOrder(ctx, plan); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to re-order roles of server %s", plan.Id.ValueString()), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete leaves the roles where they are.
+func (r *roleOrderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
@@ -0,0 +1,66 @@
+package discord
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceDiscordRoleOrder(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	if testServerID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID envvar must be set for acceptance tests")
+	}
+	name := "discord_role_order.example"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDiscordRoleOrder(testServerID, "first", "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "server_id", testServerID),
+					resource.TestCheckResourceAttr(name, "role_ids.#", "2"),
+					resource.TestCheckResourceAttrPair(name, "role_ids.0", "discord_role.first", "id"),
+					resource.TestCheckResourceAttrPair(name, "role_ids.1", "discord_role.second", "id"),
+				),
+			},
+			{
+				Config: testAccResourceDiscordRoleOrder(testServerID, "second", "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "role_ids.0", "discord_role.second", "id"),
+					resource.TestCheckResourceAttrPair(name, "role_ids.1", "discord_role.first", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceDiscordRoleOrder(serverID, top, bottom string) string {
+	return fmt.Sprintf(`
+	resource "discord_role" "first" {
+	  server_id = "%[1]s"
+	  name = "terraform-test-role-first"
+	  lifecycle {
+	    ignore_changes = [position]
+	  }
+	}
+
+	resource "discord_role" "second" {
+	  server_id = "%[1]s"
+	  name = "terraform-test-role-second"
+	  lifecycle {
+	    ignore_changes = [position]
+	  }
+	}
+
+	resource "discord_role_order" "example" {
+	  server_id = "%[1]s"
+	  role_ids = [
+	    discord_role.%[2]s.id,
+	    discord_role.%[3]s.id,
+	  ]
+	}`, serverID, top, bottom)
+}
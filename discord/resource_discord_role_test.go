@@ -15,8 +15,8 @@ func TestAccResourceDiscordRole(t *testing.T) {
 	}
 	name := "discord_role.example"
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceDiscordRole(testServerID),
@@ -28,6 +28,17 @@ func TestAccResourceDiscordRole(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "mentionable", "true"),
 					resource.TestCheckResourceAttr(name, "position", "2"),
 					resource.TestCheckResourceAttr(name, "permissions", "1024"),
+					resource.TestCheckResourceAttr(name, "permission_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr(name, "permission_names.*", "view_channel"),
+				),
+			},
+			{
+				Config: testAccResourceDiscordRolePermissionNames(testServerID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "permissions", "3072"),
+					resource.TestCheckResourceAttr(name, "permission_names.#", "2"),
+					resource.TestCheckTypeSetElemAttr(name, "permission_names.*", "view_channel"),
+					resource.TestCheckTypeSetElemAttr(name, "permission_names.*", "send_messages"),
 				),
 			},
 		},
@@ -50,3 +61,13 @@ func testAccResourceDiscordRole(channelID string) string {
         permissions = 1024
 	}`, channelID)
 }
+
+func testAccResourceDiscordRolePermissionNames(serverID string) string {
+	return fmt.Sprintf(`
+	resource "discord_role" "example" {
+		server_id = "%[1]s"
+		name = "terraform-test-role"
+		position = 2
+		permission_names = ["view_channel", "send_messages"]
+	}`, serverID)
+}
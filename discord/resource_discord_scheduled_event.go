@@ -0,0 +1,383 @@
+package discord
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/net/context"
+)
+
+type scheduledEventResource struct {
+	frameworkResource
+}
+
+type scheduledEventModel struct {
+	ServerId          types.String `tfsdk:"server_id"`
+	Name              types.String `tfsdk:"name"`
+	Description       types.String `tfsdk:"description"`
+	StartTime         types.String `tfsdk:"start_time"`
+	EndTime           types.String `tfsdk:"end_time"`
+	EntityType        types.String `tfsdk:"entity_type"`
+	ChannelId         types.String `tfsdk:"channel_id"`
+	Location          types.String `tfsdk:"location"`
+	PrivacyLevel      types.String `tfsdk:"privacy_level"`
+	Status            types.String `tfsdk:"status"`
+	CoverImageURL     types.String `tfsdk:"cover_image_url"`
+	CoverImageDataURI types.String `tfsdk:"cover_image_data_uri"`
+	CoverImageHash    types.String `tfsdk:"cover_image_hash"`
+	CreatorId         types.String `tfsdk:"creator_id"`
+	Id                types.String `tfsdk:"id"`
+}
+
+func resourceDiscordScheduledEvent() resource.Resource {
+	return &scheduledEventResource{}
+}
+
+func (r *scheduledEventResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scheduled_event"
+}
+
+func (r *scheduledEventResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// Version 1 moved the resource from the SDK to
+		// terraform-plugin-framework.
+		Version:     1,
+		Description: "A resource to create a scheduled event in a server.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "ID of the server the event is in.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Validators:  []validator.String{stringvalidator.LengthBetween(1, 100)},
+				Description: "Name of the event.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.LengthBetween(1, 1000)},
+				Description: "Description of the event.",
+			},
+			"start_time": schema.StringAttribute{
+				Required:    true,
+				Validators:  []validator.String{isRFC3339Time()},
+				Description: "When the event starts, as an RFC 3339 timestamp.",
+			},
+			"end_time": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{isRFC3339Time()},
+				Description: "When the event ends, as an RFC 3339 timestamp. Required for `external` events.",
+			},
+			"entity_type": schema.StringAttribute{
+				Required:    true,
+				Validators:  []validator.String{stringvalidator.OneOf("stage", "voice", "external")},
+				Description: "Where the event is hosted, one of `stage`, `voice` or `external`.",
+			},
+			"channel_id": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.ConflictsWith(path.MatchRoot("location"))},
+				Description: "ID of the channel the event is hosted in. Required for `stage` and `voice` events.",
+			},
+			"location": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("channel_id")),
+					stringvalidator.LengthBetween(1, 100),
+				},
+				Description: "Location of the event. Required for `external` events.",
+			},
+			"privacy_level": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("guild_only"),
+				Validators:  []validator.String{stringvalidator.OneOf("guild_only")},
+				Description: "Who can see the event. Only `guild_only` is supported by Discord.",
+			},
+			"status": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Validators:    []validator.String{stringvalidator.OneOf("scheduled", "active", "completed", "canceled")},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "Status of the event, one of `scheduled`, `active`, `completed` or `canceled`. Setting this starts, ends or cancels the event.",
+			},
+			"cover_image_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "Remote URL to set the cover image of the event to.",
+			},
+			"cover_image_data_uri": schema.StringAttribute{
+				Optional:    true,
+				Description: "Data URI of an image to set the cover image of the event to. Overrides `cover_image_url`.",
+			},
+			"cover_image_hash": schema.StringAttribute{
+				Computed:    true,
+				Description: "Hash of the cover image.",
+			},
+			"creator_id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "ID of the user who created the event.",
+			},
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The ID of the event.",
+			},
+		},
+	}
+}
+
+func (r *scheduledEventResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
+
+	return map[int64]resource.StateUpgrader{0: upgradeFromSDK(resp.Schema)}
+}
+
+// ValidateConfig checks the attributes that Discord requires for the type of
+// the event.
+func (r *scheduledEventResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config scheduledEventModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.EntityType.IsUnknown() || config.EntityType.IsNull() {
+		return
+	}
+
+	required := map[string]types.String{"channel_id": config.ChannelId}
+	events := "stage and voice events"
+	if config.EntityType.ValueString() == "external" {
+		required = map[string]types.String{"location": config.Location, "end_time": config.EndTime}
+		events = "external events"
+	}
+	for key, v := range required {
+		if v.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root(key), "Missing Attribute Configuration", fmt.Sprintf("%s is required for %s", key, events))
+		}
+	}
+}
+
+// ModifyPlan keeps the hash of the cover image while the image isn't changed.
+func (r *scheduledEventResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state scheduledEventModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.CoverImageURL.Equal(state.CoverImageURL) && plan.CoverImageDataURI.Equal(state.CoverImageDataURI) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("cover_image_hash"), state.CoverImageHash)...)
+	}
+}
+
+func (r *scheduledEventResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	serverId, eventId, err := parseTwoIds(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import scheduled event", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), eventId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), serverId)...)
+}
+
+var scheduledEventEntityTypes = map[string]discordgo.GuildScheduledEventEntityType{
+	"stage":    discordgo.GuildScheduledEventEntityTypeStageInstance,
+	"voice":    discordgo.GuildScheduledEventEntityTypeVoice,
+	"external": discordgo.GuildScheduledEventEntityTypeExternal,
+}
+
+var scheduledEventStatuses = map[string]discordgo.GuildScheduledEventStatus{
+	"scheduled": discordgo.GuildScheduledEventStatusScheduled,
+	"active":    discordgo.GuildScheduledEventStatusActive,
+	"completed": discordgo.GuildScheduledEventStatusCompleted,
+	"canceled":  discordgo.GuildScheduledEventStatusCanceled,
+}
+
+func getTextScheduledEventEntityType(entityType discordgo.GuildScheduledEventEntityType) string {
+	for k, v := range scheduledEventEntityTypes {
+		if v == entityType {
+			return k
+		}
+	}
+
+	return ""
+}
+
+func getTextScheduledEventStatus(status discordgo.GuildScheduledEventStatus) string {
+	for k, v := range scheduledEventStatuses {
+		if v == status {
+			return k
+		}
+	}
+
+	return ""
+}
+
+func buildScheduledEventParams(plan scheduledEventModel) (*discordgo.GuildScheduledEventParams, error) {
+	startTime, err := time.Parse(time.RFC3339, plan.StartTime.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	params := &discordgo.GuildScheduledEventParams{
+		Name:               plan.Name.ValueString(),
+		Description:        plan.Description.ValueString(),
+		ScheduledStartTime: &startTime,
+		PrivacyLevel:       discordgo.GuildScheduledEventPrivacyLevelGuildOnly,
+		EntityType:         scheduledEventEntityTypes[plan.EntityType.ValueString()],
+		ChannelID:          plan.ChannelId.ValueString(),
+	}
+	if !plan.EndTime.IsNull() {
+		endTime, err := time.Parse(time.RFC3339, plan.EndTime.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		params.ScheduledEndTime = &endTime
+	}
+	if !plan.Location.IsNull() {
+		params.EntityMetadata = &discordgo.GuildScheduledEventEntityMetadata{Location: plan.Location.ValueString()}
+	}
+
+	return params, nil
+}
+
+func (r *scheduledEventResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan scheduledEventModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverId := plan.ServerId.ValueString()
+	params, err := buildScheduledEventParams(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to create scheduled event in %s", serverId), err.Error())
+		return
+	}
+	params.Image = buildImage(plan.CoverImageURL, plan.CoverImageDataURI)
+
+	event, err := r.client.GuildScheduledEventCreate(serverId, params, discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to create scheduled event in %s", serverId), err.Error())
+		return
+	}
+
+	plan.Id = types.StringValue(event.ID)
+	plan.CoverImageHash = types.StringValue(event.Image)
+	plan.CreatorId = types.StringValue(event.CreatorID)
+
+	// Events are always created as scheduled, any other status is set afterwards.
+	if !plan.Status.IsUnknown() && scheduledEventStatuses[plan.Status.ValueString()] != event.Status {
+		event, err = r.client.GuildScheduledEventEdit(serverId, event.ID, &discordgo.GuildScheduledEventParams{
+			Status: scheduledEventStatuses[plan.Status.ValueString()],
+		}, discordgo.WithContext(ctx))
+		if err != nil {
+			plan.Status = types.StringValue(getTextScheduledEventStatus(discordgo.GuildScheduledEventStatusScheduled))
+			resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to update status of scheduled event %s", plan.Id.ValueString()), err.Error())
+			return
+		}
+	}
+	plan.Status = types.StringValue(getTextScheduledEventStatus(event.Status))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *scheduledEventResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state scheduledEventModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	event, err := r.client.GuildScheduledEvent(state.ServerId.ValueString(), state.Id.ValueString(), false, discordgo.WithContext(ctx))
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to fetch scheduled event %s", state.Id.ValueString()), err.Error())
+		return
+	}
+
+	state.ServerId = types.StringValue(event.GuildID)
+	state.Name = types.StringValue(event.Name)
+	state.Description = stringValue(event.Description, state.Description)
+	state.StartTime = timeValue(event.ScheduledStartTime, state.StartTime)
+	if event.ScheduledEndTime != nil {
+		state.EndTime = timeValue(*event.ScheduledEndTime, state.EndTime)
+	} else {
+		state.EndTime = types.StringNull()
+	}
+	state.EntityType = types.StringValue(getTextScheduledEventEntityType(event.EntityType))
+	state.ChannelId = stringValue(event.ChannelID, state.ChannelId)
+	state.Location = stringValue(event.EntityMetadata.Location, state.Location)
+	state.PrivacyLevel = types.StringValue("guild_only")
+	state.Status = types.StringValue(getTextScheduledEventStatus(event.Status))
+	state.CoverImageHash = types.StringValue(event.Image)
+	state.CreatorId = types.StringValue(event.CreatorID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *scheduledEventResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state scheduledEventModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	eventId := plan.Id.ValueString()
+	params, err := buildScheduledEventParams(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to update scheduled event %s", eventId), err.Error())
+		return
+	}
+
+	if !plan.CoverImageURL.Equal(state.CoverImageURL) || !plan.CoverImageDataURI.Equal(state.CoverImageDataURI) {
+		params.Image = buildImage(plan.CoverImageURL, plan.CoverImageDataURI)
+	}
+	if !plan.Status.Equal(state.Status) {
+		params.Status = scheduledEventStatuses[plan.Status.ValueString()]
+	}
+
+	event, err := r.client.GuildScheduledEventEdit(plan.ServerId.ValueString(), eventId, params, discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to update scheduled event %s", eventId), err.Error())
+		return
+	}
+
+	plan.CoverImageHash = types.StringValue(event.Image)
+	plan.Status = types.StringValue(getTextScheduledEventStatus(event.Status))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *scheduledEventResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state scheduledEventModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.GuildScheduledEventDelete(state.ServerId.ValueString(), state.Id.ValueString(), discordgo.WithContext(ctx)); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to delete scheduled event %s", state.Id.ValueString()), err.Error())
+	}
+}
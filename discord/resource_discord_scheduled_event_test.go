@@ -0,0 +1,58 @@
+package discord
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceDiscordScheduledEvent(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	if testServerID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID envvar must be set for acceptance tests")
+	}
+	name := "discord_scheduled_event.example"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDiscordScheduledEvent(testServerID, "Terraform Meetup"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "server_id", testServerID),
+					resource.TestCheckResourceAttr(name, "name", "Terraform Meetup"),
+					resource.TestCheckResourceAttr(name, "description", "Monthly meetup"),
+					resource.TestCheckResourceAttr(name, "entity_type", "external"),
+					resource.TestCheckResourceAttr(name, "location", "Community Hall"),
+					resource.TestCheckResourceAttr(name, "privacy_level", "guild_only"),
+					resource.TestCheckResourceAttr(name, "status", "scheduled"),
+					resource.TestCheckResourceAttr(name, "cover_image_url", "https://www.terraform.io/assets/images/og-image-8b3e4f7d.png"),
+					resource.TestCheckResourceAttrSet(name, "cover_image_hash"),
+					resource.TestCheckResourceAttrSet(name, "creator_id"),
+				),
+			},
+			{
+				Config: testAccResourceDiscordScheduledEvent(testServerID, "Terraform Meetup (moved)"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", "Terraform Meetup (moved)"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceDiscordScheduledEvent(serverID string, name string) string {
+	return fmt.Sprintf(`
+	resource "discord_scheduled_event" "example" {
+	  server_id = "%[1]s"
+      name = "%[2]s"
+      description = "Monthly meetup"
+      entity_type = "external"
+      location = "Community Hall"
+      start_time = "2099-01-01T18:00:00Z"
+      end_time = "2099-01-01T20:00:00Z"
+      cover_image_url = "https://www.terraform.io/assets/images/og-image-8b3e4f7d.png"
+	}`, serverID, name)
+}
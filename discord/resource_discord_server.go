@@ -4,423 +4,692 @@ import (
 	"fmt"
 
 	"github.com/bwmarrin/discordgo"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/polds/imgbase64"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"golang.org/x/net/context"
 )
 
-func baseServerSchema() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
-		"region": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Computed:    true,
-			Description: "Region of the server.",
+// serverResource is discord_server, which creates a server, and
+// discord_managed_server, which manages an existing one.
+type serverResource struct {
+	frameworkResource
+	managed bool
+}
+
+// serverModel holds the attributes of both server resources.
+// discord_managed_server has a few more of them, so they're read and written
+// one by one instead of all at once, see serverResource.get and
+// serverResource.set.
+type serverModel struct {
+	Id                          types.String
+	ServerId                    types.String
+	Name                        types.String
+	Region                      types.String
+	VerificationLevel           types.Int64
+	ExplicitContentFilter       types.Int64
+	DefaultMessageNotifications types.Int64
+	AfkChannelId                types.String
+	AfkTimeout                  types.Int64
+	IconURL                     types.String
+	IconDataURI                 types.String
+	IconHash                    types.String
+	SplashURL                   types.String
+	SplashDataURI               types.String
+	SplashHash                  types.String
+	DiscoverySplashURL          types.String
+	DiscoverySplashDataURI      types.String
+	DiscoverySplashHash         types.String
+	BannerURL                   types.String
+	BannerDataURI               types.String
+	BannerHash                  types.String
+	SystemChannelFlags          types.Int64
+	RulesChannelId              types.String
+	PublicUpdatesChannelId      types.String
+	SafetyAlertsChannelId       types.String
+	PreferredLocale             types.String
+	Description                 types.String
+	PremiumProgressBarEnabled   types.Bool
+	MfaLevel                    types.Int64
+	OwnerId                     types.String
+	Features                    types.Set
+	InvitesDisabled             types.Bool
+	RaidAlertsDisabled          types.Bool
+}
+
+// fields returns the fields of m by the names of their attributes. The fields
+// of discord_managed_server are only included when managed is true.
+func (m *serverModel) fields(managed bool) map[string]interface{} {
+	fields := map[string]interface{}{
+		"id":                            &m.Id,
+		"server_id":                     &m.ServerId,
+		"name":                          &m.Name,
+		"region":                        &m.Region,
+		"verification_level":            &m.VerificationLevel,
+		"explicit_content_filter":       &m.ExplicitContentFilter,
+		"default_message_notifications": &m.DefaultMessageNotifications,
+		"afk_channel_id":                &m.AfkChannelId,
+		"afk_timeout":                   &m.AfkTimeout,
+		"icon_url":                      &m.IconURL,
+		"icon_data_uri":                 &m.IconDataURI,
+		"icon_hash":                     &m.IconHash,
+		"splash_url":                    &m.SplashURL,
+		"splash_data_uri":               &m.SplashDataURI,
+		"splash_hash":                   &m.SplashHash,
+		"discovery_splash_url":          &m.DiscoverySplashURL,
+		"discovery_splash_data_uri":     &m.DiscoverySplashDataURI,
+		"discovery_splash_hash":         &m.DiscoverySplashHash,
+		"banner_url":                    &m.BannerURL,
+		"banner_data_uri":               &m.BannerDataURI,
+		"banner_hash":                   &m.BannerHash,
+		"system_channel_flags":          &m.SystemChannelFlags,
+		"rules_channel_id":              &m.RulesChannelId,
+		"public_updates_channel_id":     &m.PublicUpdatesChannelId,
+		"safety_alerts_channel_id":      &m.SafetyAlertsChannelId,
+		"preferred_locale":              &m.PreferredLocale,
+		"description":                   &m.Description,
+		"premium_progress_bar_enabled":  &m.PremiumProgressBarEnabled,
+		"mfa_level":                     &m.MfaLevel,
+		"owner_id":                      &m.OwnerId,
+	}
+	if managed {
+		fields["features"] = &m.Features
+		fields["invites_disabled"] = &m.InvitesDisabled
+		fields["raid_alerts_disabled"] = &m.RaidAlertsDisabled
+	}
+
+	return fields
+}
+
+// newServerModel returns a model with all attributes null. Features is
+// typed, unlike in the zero value, so it can be compared.
+func newServerModel() serverModel {
+	return serverModel{Features: types.SetNull(types.StringType)}
+}
+
+func resourceDiscordServer() resource.Resource {
+	return &serverResource{}
+}
+
+func resourceDiscordManagedServer() resource.Resource {
+	return &serverResource{managed: true}
+}
+
+func (r *serverResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	if r.managed {
+		resp.TypeName = req.ProviderTypeName + "_managed_server"
+		return
+	}
+
+	resp.TypeName = req.ProviderTypeName + "_server"
+}
+
+func (r *serverResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"region": schema.StringAttribute{
+			Optional:      true,
+			Computed:      true,
+			PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			Description:   "Region of the server.",
 		},
-		"verification_level": {
-			Type:        schema.TypeInt,
+		"verification_level": schema.Int64Attribute{
 			Optional:    true,
-			Default:     0,
+			Computed:    true,
+			Default:     int64default.StaticInt64(0),
+			Validators:  []validator.Int64{int64validator.Between(0, 4)},
 			Description: "Verification level of the server.",
-			ValidateFunc: func(val interface{}, key string) (warns []string, errors []error) {
-				v := val.(int)
-				if v > 4 || v < 0 {
-					errors = append(errors, fmt.Errorf("verification_level must be between 0 and 4 inclusive, got: %d", v))
-				}
-
-				return
-			},
 		},
-		"explicit_content_filter": {
-			Type:        schema.TypeInt,
+		"explicit_content_filter": schema.Int64Attribute{
 			Optional:    true,
-			Default:     0,
+			Computed:    true,
+			Default:     int64default.StaticInt64(0),
+			Validators:  []validator.Int64{int64validator.Between(0, 2)},
 			Description: "Explicit content filter level of the server.",
-			ValidateFunc: func(val interface{}, key string) (warns []string, errors []error) {
-				v := val.(int)
-				if v > 2 || v < 0 {
-					errors = append(errors, fmt.Errorf("explicit_content_filter must be between 0 and 2 inclusive, got: %d", v))
-				}
-
-				return
-			},
 		},
-		"default_message_notifications": {
-			Type:        schema.TypeInt,
+		"default_message_notifications": schema.Int64Attribute{
 			Optional:    true,
-			Default:     0,
+			Computed:    true,
+			Default:     int64default.StaticInt64(0),
+			Validators:  []validator.Int64{int64validator.OneOf(0, 1)},
 			Description: "Default message notification settings. (`0` = all messages, `1` = mentions)",
-			ValidateFunc: func(val interface{}, key string) (warns []string, errors []error) {
-				v := val.(int)
-				if v != 0 && v != 1 {
-					errors = append(errors, fmt.Errorf("default_message_notifications must be 0 or 1, got: %d", v))
-				}
-
-				return
-			},
 		},
-		"afk_channel_id": {
-			Type:        schema.TypeString,
+		"afk_channel_id": schema.StringAttribute{
 			Optional:    true,
 			Description: "ID of the channel AFK users will be moved to.",
 		},
-		"afk_timeout": {
-			Type:        schema.TypeInt,
-			Optional:    true,
-			Default:     300,
+		"afk_timeout": schema.Int64Attribute{
+			Optional: true,
+			Computed: true,
+			Default:  int64default.StaticInt64(300),
+			// See: https://discord.com/developers/docs/resources/guild#guild-object-guild-structure
+			Validators:  []validator.Int64{int64validator.OneOf(60, 300, 900, 1800, 3600)},
 			Description: "How many seconds before moving an AFK user.",
-			ValidateFunc: func(val interface{}, key string) (warns []string, errors []error) {
-				v := val.(int)
-				// See: https://discord.com/developers/docs/resources/guild#guild-object-guild-structure
-				expected := []int{60, 300, 900, 1800, 3600}
-				if !contains(expected, v) {
-					errors = append(errors, fmt.Errorf("afk_timeout must be set to one of the following values: %d, but got: %d", expected, v))
-				}
-
-				return
-			},
 		},
-		"icon_url": {
-			Type:        schema.TypeString,
+		"icon_url": schema.StringAttribute{
 			Optional:    true,
 			Description: "Remote URL to set the icon of the server to.",
 		},
-		"icon_data_uri": {
-			Type:        schema.TypeString,
+		"icon_data_uri": schema.StringAttribute{
 			Optional:    true,
 			Description: "Data URI of an image to set the server icon to. Overrides `icon_url`.",
 		},
-		"icon_hash": {
-			Type:        schema.TypeString,
+		"icon_hash": schema.StringAttribute{
 			Computed:    true,
 			Description: "Hash of the icon.",
 		},
-		"splash_url": {
-			Type:        schema.TypeString,
+		"splash_url": schema.StringAttribute{
 			Optional:    true,
 			Description: "Remote URL to set the splash image of the server to.",
 		},
-		"splash_data_uri": {
-			Type:        schema.TypeString,
+		"splash_data_uri": schema.StringAttribute{
 			Optional:    true,
 			Description: "Data URI of an image to set the splash image of the server to. Overrides `splash_url`",
 		},
-		"splash_hash": {
-			Type:        schema.TypeString,
+		"splash_hash": schema.StringAttribute{
 			Computed:    true,
 			Description: "Hash of the splash.",
 		},
-		"owner_id": {
-			Type:        schema.TypeString,
+		"discovery_splash_url": schema.StringAttribute{
+			Optional:    true,
+			Description: "Remote URL to set the discovery splash image of the server to.",
+		},
+		"discovery_splash_data_uri": schema.StringAttribute{
 			Optional:    true,
+			Description: "Data URI of an image to set the discovery splash image of the server to. Overrides `discovery_splash_url`",
+		},
+		"discovery_splash_hash": schema.StringAttribute{
 			Computed:    true,
-			Description: "Owner ID of the server. Setting this will transfer ownership.",
+			Description: "Hash of the discovery splash.",
+		},
+		"banner_url": schema.StringAttribute{
+			Optional:    true,
+			Description: "Remote URL to set the banner of the server to.",
+		},
+		"banner_data_uri": schema.StringAttribute{
+			Optional:    true,
+			Description: "Data URI of an image to set the banner of the server to. Overrides `banner_url`",
 		},
-		"id": {
-			Type:        schema.TypeString,
+		"banner_hash": schema.StringAttribute{
 			Computed:    true,
-			Description: "The ID of the server.",
+			Description: "Hash of the banner.",
+		},
+		"system_channel_flags": schema.Int64Attribute{
+			Optional:    true,
+			Validators:  []validator.Int64{int64validator.Between(0, 15)},
+			Description: "Bits of the system channel messages to suppress. (`1` = member joins, `2` = boosts, `4` = setup tips, `8` = sticker replies to member joins)",
+		},
+		"rules_channel_id": schema.StringAttribute{
+			Optional:    true,
+			Description: "ID of the channel with the rules of the server. Only for community servers.",
+		},
+		"public_updates_channel_id": schema.StringAttribute{
+			Optional:    true,
+			Description: "ID of the channel Discord sends community updates to. Only for community servers.",
+		},
+		"safety_alerts_channel_id": schema.StringAttribute{
+			Optional:    true,
+			Description: "ID of the channel Discord sends safety alerts to. Only for community servers.",
+		},
+		"preferred_locale": schema.StringAttribute{
+			Optional:    true,
+			Description: "Preferred locale of the server, such as `en-US`. Only for community servers.",
+		},
+		"description": schema.StringAttribute{
+			Optional:    true,
+			Description: "Description of the server. Only for community servers.",
+		},
+		"premium_progress_bar_enabled": schema.BoolAttribute{
+			Optional:    true,
+			Description: "Whether the boost progress bar is shown.",
+		},
+		"mfa_level": schema.Int64Attribute{
+			Optional:    true,
+			Validators:  []validator.Int64{int64validator.OneOf(0, 1)},
+			Description: "Whether moderators need two-factor authentication. (`0` = no, `1` = yes) Only the owner can change this.",
+		},
+		"owner_id": schema.StringAttribute{
+			Optional:      true,
+			Computed:      true,
+			PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			Description:   "Owner ID of the server. Setting this will transfer ownership.",
+		},
+		"id": schema.StringAttribute{
+			Computed:      true,
+			PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			Description:   "The ID of the server.",
 		},
 	}
-}
-
-func managedServerSchema() map[string]*schema.Schema {
-	res := baseServerSchema()
 
-	res["server_id"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Required:    true,
-		Description: "The ID of the server to manage.",
+	description := "A resource to create a server."
+	if r.managed {
+		attributes["server_id"] = schema.StringAttribute{
+			Required:    true,
+			Description: "The ID of the server to manage.",
+		}
+		attributes["name"] = schema.StringAttribute{
+			Optional:    true,
+			Description: "Name of the server.",
+		}
+		attributes["features"] = schema.SetAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Validators:  []validator.Set{setvalidator.ValueStringsAre(stringvalidator.OneOf(communityFeatures...))},
+			Description: "Community features of the server, `COMMUNITY` and `DISCOVERABLE`. Enabling `COMMUNITY` requires `rules_channel_id` and `public_updates_channel_id` to be set, a `verification_level` of at least `1` and an `explicit_content_filter` of `2`. `DISCOVERABLE` requires `COMMUNITY`. Other features are left as they are, such as `WELCOME_SCREEN_ENABLED`, which is managed with `discord_welcome_screen`.",
+		}
+		attributes["invites_disabled"] = schema.BoolAttribute{
+			Optional:    true,
+			Description: "Whether invites to the server are paused.",
+		}
+		attributes["raid_alerts_disabled"] = schema.BoolAttribute{
+			Optional:    true,
+			Description: "Whether alerts about raids on the server are disabled.",
+		}
+	} else {
+		attributes["server_id"] = schema.StringAttribute{
+			Computed:      true,
+			PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			Description:   "The ID of the server to manage.",
+		}
+		attributes["name"] = schema.StringAttribute{
+			Required:    true,
+			Description: "Name of the server.",
+		}
 	}
-	res["name"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "Name of the server.",
+
+	resp.Schema = schema.Schema{
+		// Version 1 moved the resource from the SDK to
+		// terraform-plugin-framework.
+		Version:     1,
+		Description: description,
+		Attributes:  attributes,
 	}
+}
 
-	return res
+func (r *serverResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &resp.Schema,
+			// The SDK already kept the settings that aren't configured out
+			// of state, see setServerSetting, so the state is taken as is.
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				resp.State.Raw = req.State.Raw
+			},
+		},
+	}
 }
 
-func serverSchema() map[string]*schema.Schema {
-	res := baseServerSchema()
+func (r *serverResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
 
-	res["server_id"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Computed:    true,
-		Description: "The ID of the server to manage.",
-	}
-	res["name"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Required:    true,
-		Description: "Name of the server.",
+// get reads the attributes of the resource from data into m.
+func (r *serverResource) get(ctx context.Context, data channelData, m *serverModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for name, field := range m.fields(r.managed) {
+		diags.Append(data.GetAttribute(ctx, path.Root(name), field)...)
 	}
 
-	return res
+	return diags
 }
 
-func resourceDiscordServer() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: resourceServerCreate,
-		ReadContext:   resourceServerRead,
-		UpdateContext: resourceServerUpdate,
-		DeleteContext: resourceServerDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
-		Description: "A resource to create a server.",
-		Schema:      serverSchema(),
+// set writes the attributes of the resource from m into state.
+func (r *serverResource) set(ctx context.Context, state *tfsdk.State, m *serverModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for name, field := range m.fields(r.managed) {
+		diags.Append(state.SetAttribute(ctx, path.Root(name), field)...)
 	}
-}
 
-func resourceDiscordManagedServer() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: resourceServerManagedCreate,
-		ReadContext:   resourceServerRead,
-		UpdateContext: resourceServerUpdate,
-		DeleteContext: resourceServerManagedDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+	return diags
+}
 
-		Description: "A resource to create a server.",
-		Schema:      managedServerSchema(),
+// ValidateConfig checks the requirements Discord has for the configured
+// community features, which it otherwise rejects with a bare 400.
+func (r *serverResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	if !r.managed {
+		return
 	}
-}
 
-func resourceServerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	client := m.(*Context).Session
+	config := newServerModel()
+	resp.Diagnostics.Append(r.get(ctx, req.Config, &config)...)
+	if resp.Diagnostics.HasError() || config.Features.IsNull() {
+		return
+	}
+	features, known := setStrings(ctx, config.Features)
+	if !known {
+		return
+	}
 
-	icon := ""
-	if v, ok := d.GetOk("icon_url"); ok {
-		icon = imgbase64.FromRemote(v.(string))
+	if contains(features, "DISCOVERABLE") && !contains(features, "COMMUNITY") {
+		resp.Diagnostics.AddAttributeError(path.Root("features"), "Invalid Attribute Combination", "the DISCOVERABLE feature requires the COMMUNITY feature")
 	}
-	if v, ok := d.GetOk("icon_data_uri"); ok {
-		icon = v.(string)
+	if !contains(features, "COMMUNITY") {
+		return
 	}
 
-	name := d.Get("name").(string)
-	// DiscordGo doesn't support creating a server with anything apart from a name.
-	server, err := client.GuildCreate(name, discordgo.WithContext(ctx))
-	if err != nil {
-		return diag.Errorf("Failed to create server: %s", err.Error())
+	for key, v := range map[string]types.String{"rules_channel_id": config.RulesChannelId, "public_updates_channel_id": config.PublicUpdatesChannelId} {
+		if !v.IsUnknown() && v.ValueString() == "" {
+			resp.Diagnostics.AddAttributeError(path.Root(key), "Missing Attribute Configuration", fmt.Sprintf("the COMMUNITY feature requires %s to be set", key))
+		}
+	}
+	if !config.VerificationLevel.IsUnknown() && config.VerificationLevel.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("verification_level"), "Invalid Attribute Value", "the COMMUNITY feature requires a verification_level of at least 1")
+	}
+	if !config.ExplicitContentFilter.IsUnknown() && config.ExplicitContentFilter.ValueInt64() != 2 {
+		resp.Diagnostics.AddAttributeError(path.Root("explicit_content_filter"), "Invalid Attribute Value", "the COMMUNITY feature requires an explicit_content_filter of 2")
 	}
+}
 
-	splash := ""
-	if v, ok := d.GetOk("splash_url"); ok {
-		splash = imgbase64.FromRemote(v.(string))
+// ModifyPlan keeps the hashes of the images that don't change, which are
+// otherwise only known after apply.
+func (r *serverResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
 	}
-	if v, ok := d.GetOk("splash_data_uri"); ok {
-		splash = v.(string)
+
+	plan, state := newServerModel(), newServerModel()
+	resp.Diagnostics.Append(r.get(ctx, req.Plan, &plan)...)
+	resp.Diagnostics.Append(r.get(ctx, req.State, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	if splash != "" {
+
+	for hash, v := range map[string]struct{ plan, state [2]types.String }{
+		"icon_hash":             {[2]types.String{plan.IconURL, plan.IconDataURI}, [2]types.String{state.IconURL, state.IconDataURI}},
+		"splash_hash":           {[2]types.String{plan.SplashURL, plan.SplashDataURI}, [2]types.String{state.SplashURL, state.SplashDataURI}},
+		"discovery_splash_hash": {[2]types.String{plan.DiscoverySplashURL, plan.DiscoverySplashDataURI}, [2]types.String{state.DiscoverySplashURL, state.DiscoverySplashDataURI}},
+		"banner_hash":           {[2]types.String{plan.BannerURL, plan.BannerDataURI}, [2]types.String{state.BannerURL, state.BannerDataURI}},
+	} {
+		if v.plan[0].Equal(v.state[0]) && v.plan[1].Equal(v.state[1]) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(hash), state.fields(r.managed)[hash])...)
+		}
 	}
+}
 
-	afkChannel := server.AfkChannelID
-	if v, ok := d.GetOk("afk_channel_id"); ok {
-		afkChannel = v.(string)
+func (r *serverResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := newServerModel()
+	resp.Diagnostics.Append(r.get(ctx, req.Plan, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	afkTimeOut := server.AfkTimeout
-	if v, ok := d.GetOk("afk_timeout"); ok {
-		// The value has been already validated, so this cast is safe.
-		afkTimeOut = v.(int)
+
+	if r.managed {
+		plan.Id = plan.ServerId
+
+		// Adopting a server applies its configuration right away, instead
+		// of leaving it to the next apply.
+		resp.Diagnostics.Append(r.update(ctx, plan, newServerModel())...)
+		resp.Diagnostics.Append(r.setComputed(ctx, &plan)...)
+		resp.Diagnostics.Append(r.set(ctx, &resp.State, &plan)...)
+		return
 	}
 
-	verificationLevel := discordgo.VerificationLevel(d.Get("verification_level").(int))
-	server, err = client.GuildEdit(server.ID, &discordgo.GuildParams{
-		Icon:                        icon,
-		Region:                      d.Get("region").(string),
+	// DiscordGo doesn't support creating a server with anything apart from a name.
+	server, err := r.client.GuildCreate(plan.Name.ValueString(), discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create server", err.Error())
+		return
+	}
+	// The server is tracked even when the rest of the setup fails, so it
+	// isn't orphaned.
+	plan.Id = types.StringValue(server.ID)
+	defer func() {
+		resp.Diagnostics.Append(r.setComputed(ctx, &plan)...)
+		resp.Diagnostics.Append(r.set(ctx, &resp.State, &plan)...)
+	}()
+
+	afkChannelId := server.AfkChannelID
+	if v := plan.AfkChannelId.ValueString(); v != "" {
+		afkChannelId = v
+	}
+	verificationLevel := discordgo.VerificationLevel(plan.VerificationLevel.ValueInt64())
+	server, err = r.client.GuildEdit(server.ID, &discordgo.GuildParams{
+		Icon:                        buildImage(plan.IconURL, plan.IconDataURI),
+		Region:                      plan.Region.ValueString(),
 		VerificationLevel:           &verificationLevel,
-		DefaultMessageNotifications: d.Get("default_message_notifications").(int),
-		ExplicitContentFilter:       d.Get("explicit_content_filter").(int),
-		AfkChannelID:                afkChannel,
-		AfkTimeout:                  afkTimeOut,
-		Splash:                      splash,
+		DefaultMessageNotifications: int(plan.DefaultMessageNotifications.ValueInt64()),
+		ExplicitContentFilter:       int(plan.ExplicitContentFilter.ValueInt64()),
+		AfkChannelID:                afkChannelId,
+		AfkTimeout:                  int(plan.AfkTimeout.ValueInt64()),
+		Splash:                      buildImage(plan.SplashURL, plan.SplashDataURI),
+		DiscoverySplash:             buildImage(plan.DiscoverySplashURL, plan.DiscoverySplashDataURI),
+		Banner:                      buildImage(plan.BannerURL, plan.BannerDataURI),
 	}, discordgo.WithContext(ctx))
 	if err != nil {
-		return diag.Errorf("Failed to edit server: %s", err.Error())
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to edit server %s", plan.Id.ValueString()), err.Error())
+		return
+	}
+	if err := updateServerSettings(r.client, ctx, server, plan, newServerModel()); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to edit server %s", server.ID), err.Error())
+		return
 	}
 
 	for _, channel := range server.Channels {
-		if _, err := client.ChannelDelete(channel.ID); err != nil {
-			return diag.Errorf("Failed to delete channel for new server: %s", err.Error())
+		if _, err := r.client.ChannelDelete(channel.ID, discordgo.WithContext(ctx)); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to delete channel %s of new server %s", channel.ID, server.ID), err.Error())
+			return
 		}
 	}
 
-	// Update owner's ID if the specified one is not as same as default,
-	// because we will receive "User is already owner" error if update to the same one.
-	ownerId := server.OwnerID
-	if v, ok := d.GetOk("owner_id"); ok {
-		newOwnerId := v.(string)
-		if ownerId != newOwnerId {
-			ownerId = newOwnerId
+	// The owner is only changed when it's another one, as Discord rejects
+	// a transfer to the current owner.
+	if ownerId := plan.OwnerId.ValueString(); ownerId != "" && ownerId != server.OwnerID {
+		if _, err := r.client.GuildEdit(server.ID, &discordgo.GuildParams{OwnerID: ownerId}, discordgo.WithContext(ctx)); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to transfer server %s", server.ID), err.Error())
 		}
-		server, err = client.GuildEdit(server.ID, &discordgo.GuildParams{
-			OwnerID: ownerId,
-		}, discordgo.WithContext(ctx))
 	}
+}
 
-	d.SetId(server.ID)
-	if _, ok := d.GetOk("owner_id"); !ok {
-		d.Set("owner_id", server.OwnerID)
+func (r *serverResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := newServerModel()
+	resp.Diagnostics.Append(r.get(ctx, req.State, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	d.Set("region", server.Region)
-	d.Set("icon_hash", server.Icon)
-	d.Set("splash_hash", server.Splash)
 
-	return diags
-}
-
-func resourceServerManagedCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
+	server, settings, err := getServerWithSettings(r.client, ctx, state.Id.ValueString())
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 
-	serverIdInterface, ok := d.GetOk("server_id")
-	if !ok {
-		return diag.Errorf("Error: server_id must be set")
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to fetch server %s", state.Id.ValueString()), err.Error())
+		return
 	}
-	serverId, ok := serverIdInterface.(string)
-	if !ok {
-		return diag.Errorf("Error: server_id must be a string")
-	}
-
-	d.SetId(serverId)
 
-	return diags
+	r.setServerData(&state, server, settings)
+	resp.Diagnostics.Append(r.set(ctx, &resp.State, &state)...)
 }
 
-func resourceServerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	client := m.(*Context).Session
-
-	server, err := client.Guild(d.Id(), discordgo.WithContext(ctx))
-	if err != nil {
-		return diag.Errorf("Error fetching server: %s", err.Error())
-	}
-
-	d.Set("name", server.Name)
-	d.Set("region", server.Region)
-	d.Set("default_message_notifications", server.DefaultMessageNotifications)
-	d.Set("afk_timeout", server.AfkTimeout)
-	d.Set("icon_hash", server.Icon)
-	d.Set("splash_hash", server.Splash)
-	d.Set("verification_level", server.VerificationLevel)
-	d.Set("default_message_notifications", server.DefaultMessageNotifications)
-	d.Set("explicit_content_filter", server.ExplicitContentFilter)
+// setServerData sets the attributes of m from server. Settings are only
+// refreshed while they're managed, so that settings that aren't configured
+// stay out of state and settings that leave the configuration show up as
+// removed. A setting is managed while it's not null. Zero values count too,
+// so drift from an explicit `0` or `false` shows up.
+func (r *serverResource) setServerData(m *serverModel, server *discordgo.Guild, settings *serverSettings) {
+	m.ServerId = types.StringValue(server.ID)
+	m.Region = types.StringValue(server.Region)
+	m.VerificationLevel = types.Int64Value(int64(server.VerificationLevel))
+	m.ExplicitContentFilter = types.Int64Value(int64(server.ExplicitContentFilter))
+	m.DefaultMessageNotifications = types.Int64Value(int64(server.DefaultMessageNotifications))
+	m.AfkTimeout = types.Int64Value(int64(server.AfkTimeout))
 	if server.AfkChannelID != "" {
-		d.Set("afk_channel_id", server.AfkChannelID)
+		m.AfkChannelId = types.StringValue(server.AfkChannelID)
+	}
+	m.IconHash = types.StringValue(server.Icon)
+	m.SplashHash = types.StringValue(server.Splash)
+	m.DiscoverySplashHash = types.StringValue(server.DiscoverySplash)
+	m.BannerHash = types.StringValue(server.Banner)
+	// The owner is left as it is in state until it's known.
+	if server.OwnerID != "" {
+		m.OwnerId = types.StringValue(server.OwnerID)
+	}
+
+	setServerSetting(&m.SystemChannelFlags, types.Int64Value(int64(server.SystemChannelFlags)))
+	setServerSetting(&m.RulesChannelId, types.StringValue(server.RulesChannelID))
+	setServerSetting(&m.PublicUpdatesChannelId, types.StringValue(server.PublicUpdatesChannelID))
+	setServerSetting(&m.SafetyAlertsChannelId, types.StringValue(settings.SafetyAlertsChannelID))
+	setServerSetting(&m.PreferredLocale, types.StringValue(server.PreferredLocale))
+	setServerSetting(&m.Description, types.StringValue(server.Description))
+	setServerSetting(&m.PremiumProgressBarEnabled, types.BoolValue(settings.PremiumProgressBarEnabled))
+	setServerSetting(&m.MfaLevel, types.Int64Value(int64(server.MfaLevel)))
+
+	if !r.managed {
+		m.Name = types.StringValue(server.Name)
+		return
+	}
+
+	setServerSetting(&m.Name, types.StringValue(server.Name))
+	if !m.Features.IsNull() {
+		features := make([]string, 0)
+		for _, feature := range serverFeatures(server) {
+			if contains(communityFeatures, feature) {
+				features = append(features, feature)
+			}
+		}
+		m.Features, _ = types.SetValueFrom(context.Background(), types.StringType, features)
 	}
+	setServerSetting(&m.InvitesDisabled, types.BoolValue(contains(serverFeatures(server), "INVITES_DISABLED")))
+	setServerSetting(&m.RaidAlertsDisabled, types.BoolValue(contains(serverFeatures(server), "RAID_ALERTS_DISABLED")))
+}
 
-	// We don't want to set the owner to null, should only change this if it's changing to something else
-	if d.Get("owner_id").(string) != "" && server.OwnerID != "" {
-		d.Set("owner_id", server.OwnerID)
+func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan, state := newServerModel(), newServerModel()
+	resp.Diagnostics.Append(r.get(ctx, req.Plan, &plan)...)
+	resp.Diagnostics.Append(r.get(ctx, req.State, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	return diags
+	resp.Diagnostics.Append(r.update(ctx, plan, state)...)
+	resp.Diagnostics.Append(r.setComputed(ctx, &plan)...)
+	resp.Diagnostics.Append(r.set(ctx, &resp.State, &plan)...)
 }
 
-func resourceServerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+// update applies the settings of plan that differ from prior.
+func (r *serverResource) update(ctx context.Context, plan serverModel, prior serverModel) diag.Diagnostics {
 	var diags diag.Diagnostics
-	client := m.(*Context).Session
+	serverId := plan.Id.ValueString()
 
-	server, err := client.Guild(d.Id(), discordgo.WithContext(ctx))
+	server, err := r.client.Guild(serverId, discordgo.WithContext(ctx))
 	if err != nil {
-		return diag.Errorf("Error fetching server: %s", err.Error())
+		diags.AddError(fmt.Sprintf("Failed to fetch server %s", serverId), err.Error())
+		return diags
 	}
 
-	guildParams := &discordgo.GuildParams{}
+	params := &discordgo.GuildParams{}
 	edit := false
 
-	if d.HasChange("icon_url") {
-		guildParams.Icon = imgbase64.FromRemote(d.Get("icon_url").(string))
-		edit = true
-	}
-	if d.HasChange("icon_data_uri") {
-		guildParams.Icon = d.Get("icon_data_uri").(string)
-		edit = true
-	}
-	if d.HasChange("splash_url") {
-		guildParams.Splash = imgbase64.FromRemote(d.Get("splash_url").(string))
+	if !plan.IconURL.Equal(prior.IconURL) || !plan.IconDataURI.Equal(prior.IconDataURI) {
+		params.Icon = buildImage(plan.IconURL, plan.IconDataURI)
 		edit = true
 	}
-	if d.HasChange("splash_data_uri") {
-		guildParams.Splash = d.Get("splash_data_uri").(string)
+	if !plan.SplashURL.Equal(prior.SplashURL) || !plan.SplashDataURI.Equal(prior.SplashDataURI) {
+		params.Splash = buildImage(plan.SplashURL, plan.SplashDataURI)
 		edit = true
 	}
-	if d.HasChange("afk_channel_id") {
-		guildParams.AfkChannelID = d.Get("afk_channel_id").(string)
+	if !plan.DiscoverySplashURL.Equal(prior.DiscoverySplashURL) || !plan.DiscoverySplashDataURI.Equal(prior.DiscoverySplashDataURI) {
+		params.DiscoverySplash = buildImage(plan.DiscoverySplashURL, plan.DiscoverySplashDataURI)
 		edit = true
 	}
-	if d.HasChange("afk_timeout") {
-		guildParams.AfkTimeout = d.Get("afk_timeout").(int)
+	if !plan.BannerURL.Equal(prior.BannerURL) || !plan.BannerDataURI.Equal(prior.BannerDataURI) {
+		params.Banner = buildImage(plan.BannerURL, plan.BannerDataURI)
 		edit = true
 	}
-
-	if d.HasChange("owner_id") {
-		guildParams.OwnerID = d.Get("owner_id").(string)
+	if !plan.AfkChannelId.Equal(prior.AfkChannelId) {
+		params.AfkChannelID = plan.AfkChannelId.ValueString()
 		edit = true
 	}
-	if d.HasChange("verification_level") {
-		verificationLevel := discordgo.VerificationLevel(d.Get("verification_level").(int))
-		guildParams.VerificationLevel = &verificationLevel
+	if !plan.AfkTimeout.Equal(prior.AfkTimeout) {
+		params.AfkTimeout = int(plan.AfkTimeout.ValueInt64())
 		edit = true
 	}
-
-	if d.HasChange("default_message_notifications") {
-		guildParams.DefaultMessageNotifications = d.Get("default_message_notifications").(int)
+	if !plan.VerificationLevel.Equal(prior.VerificationLevel) {
+		verificationLevel := discordgo.VerificationLevel(plan.VerificationLevel.ValueInt64())
+		params.VerificationLevel = &verificationLevel
 		edit = true
 	}
-	if d.HasChange("explicit_content_filter") {
-		guildParams.ExplicitContentFilter = d.Get("explicit_content_filter").(int)
+	if !plan.Name.IsNull() && !plan.Name.Equal(prior.Name) {
+		params.Name = plan.Name.ValueString()
 		edit = true
 	}
-	if d.HasChange("name") {
-		guildParams.Name = d.Get("name").(string)
+	if !plan.Region.IsUnknown() && !plan.Region.Equal(prior.Region) {
+		params.Region = plan.Region.ValueString()
 		edit = true
 	}
-	if d.HasChange("region") {
-		guildParams.Region = d.Get("region").(string)
+	// The owner is only changed when it's another one, as Discord rejects
+	// a transfer to the current owner.
+	if ownerId := plan.OwnerId.ValueString(); ownerId != "" && ownerId != server.OwnerID {
+		params.OwnerID = ownerId
 		edit = true
 	}
 
-	ownerId, hasOwner := d.GetOk("owner_id")
-	if d.HasChange("owner_id") {
-		if hasOwner {
-			guildParams.OwnerID = ownerId.(string)
-			edit = true
-		}
-	} else {
-		if hasOwner {
-			guildParams.OwnerID = server.OwnerID
-			edit = true
-		}
-	}
-
 	if edit {
-		if _, err = client.GuildEdit(server.ID, guildParams, discordgo.WithContext(ctx)); err != nil {
-			return diag.Errorf("Failed to edit server: %s", err.Error())
+		if _, err = r.client.GuildEdit(server.ID, params, discordgo.WithContext(ctx)); err != nil {
+			diags.AddError(fmt.Sprintf("Failed to edit server %s", server.ID), err.Error())
+			return diags
 		}
 	}
+	if err := updateServerSettings(r.client, ctx, server, plan, prior); err != nil {
+		diags.AddError(fmt.Sprintf("Failed to edit server %s", server.ID), err.Error())
+	}
 
 	return diags
 }
 
-func resourceServerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+// setComputed fills in the values of plan that are only known once the
+// server was created or updated.
+func (r *serverResource) setComputed(ctx context.Context, plan *serverModel) diag.Diagnostics {
 	var diags diag.Diagnostics
-	client := m.(*Context).Session
-
-	if err := client.GuildDelete(d.Id(), discordgo.WithContext(ctx)); err != nil {
-		return diag.Errorf("Failed to delete server: %s", err)
+	server, _, err := getServerWithSettings(r.client, ctx, plan.Id.ValueString())
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Failed to fetch server %s", plan.Id.ValueString()), err.Error())
+		server = &discordgo.Guild{ID: plan.Id.ValueString()}
+	}
+
+	plan.ServerId = types.StringValue(server.ID)
+	for v, value := range map[*types.String]string{
+		&plan.Region:              server.Region,
+		&plan.OwnerId:             server.OwnerID,
+		&plan.IconHash:            server.Icon,
+		&plan.SplashHash:          server.Splash,
+		&plan.DiscoverySplashHash: server.DiscoverySplash,
+		&plan.BannerHash:          server.Banner,
+	} {
+		if v.IsUnknown() {
+			*v = types.StringValue(value)
+		}
 	}
 
 	return diags
 }
 
-func resourceServerManagedDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
+func (r *serverResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Managed servers are left as they are.
+	if r.managed {
+		return
+	}
 
-	// noop
+	state := newServerModel()
+	resp.Diagnostics.Append(r.get(ctx, req.State, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	return diags
+	if err := r.client.GuildDelete(state.Id.ValueString(), discordgo.WithContext(ctx)); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to delete server %s", state.Id.ValueString()), err.Error())
+	}
 }
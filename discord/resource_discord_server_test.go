@@ -9,8 +9,8 @@ import (
 func TestAccResourceDiscordServer(t *testing.T) {
 	name := "discord_server.example"
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceDiscordServer,
@@ -22,6 +22,15 @@ func TestAccResourceDiscordServer(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "explicit_content_filter", "0"),
 					resource.TestCheckResourceAttr(name, "afk_timeout", "300"),
 					resource.TestCheckResourceAttrSet(name, "owner_id"),
+					resource.TestCheckResourceAttr(name, "system_channel_flags", "5"),
+					resource.TestCheckResourceAttr(name, "premium_progress_bar_enabled", "true"),
+				),
+			},
+			{
+				Config: testAccResourceDiscordServerWithoutSettings,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(name, "system_channel_flags"),
+					resource.TestCheckNoResourceAttr(name, "premium_progress_bar_enabled"),
 				),
 			},
 		},
@@ -29,6 +38,14 @@ func TestAccResourceDiscordServer(t *testing.T) {
 }
 
 const testAccResourceDiscordServer = `
+resource "discord_server" "example" {
+  name = "example"
+  system_channel_flags = 5
+  premium_progress_bar_enabled = true
+}
+`
+
+const testAccResourceDiscordServerWithoutSettings = `
 resource "discord_server" "example" {
   name = "example"
 }
@@ -0,0 +1,207 @@
+package discord
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/net/context"
+)
+
+type serverWidgetResource struct {
+	frameworkResource
+}
+
+type serverWidgetModel struct {
+	ServerId  types.String `tfsdk:"server_id"`
+	Enabled   types.Bool   `tfsdk:"enabled"`
+	ChannelId types.String `tfsdk:"channel_id"`
+	JsonURL   types.String `tfsdk:"json_url"`
+	ImageURL  types.String `tfsdk:"image_url"`
+	Id        types.String `tfsdk:"id"`
+}
+
+func resourceDiscordServerWidget() resource.Resource {
+	return &serverWidgetResource{}
+}
+
+func (r *serverWidgetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_widget"
+}
+
+func (r *serverWidgetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// Version 1 moved the resource from the SDK to
+		// terraform-plugin-framework.
+		Version:     1,
+		Description: "A resource to manage the widget of a server. Deleting it disables the widget.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "ID of the server the widget is for.",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the widget is enabled.",
+			},
+			"channel_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the channel the widget invites people to.",
+			},
+			"json_url": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "URL of the widget's JSON, only served while the widget is enabled.",
+			},
+			"image_url": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "URL of the widget's PNG image, only served while the widget is enabled.",
+			},
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The ID of the server.",
+			},
+		},
+	}
+}
+
+func (r *serverWidgetResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
+
+	return map[int64]resource.StateUpgrader{0: upgradeFromSDK(resp.Schema)}
+}
+
+func (r *serverWidgetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), req.ID)...)
+}
+
+// read refreshes m with the widget of its server, and reports whether the
+// server still exists.
+func (r *serverWidgetResource) read(ctx context.Context, m *serverWidgetModel) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	serverId := m.ServerId.ValueString()
+	server, err := r.client.Guild(serverId, discordgo.WithContext(ctx))
+	if err != nil {
+		if isNotFound(err) {
+			return false, diags
+		}
+
+		diags.AddError(fmt.Sprintf("Failed to fetch server %s", serverId), err.Error())
+		return true, diags
+	}
+
+	m.Id = types.StringValue(serverId)
+	m.Enabled = types.BoolValue(server.WidgetEnabled)
+	m.ChannelId = stringValue(server.WidgetChannelID, m.ChannelId)
+	m.JsonURL = types.StringValue(discordgo.EndpointGuildWidget(serverId) + ".json")
+	m.ImageURL = types.StringValue(discordgo.EndpointGuildWidget(serverId) + ".png")
+
+	return true, diags
+}
+
+func (r *serverWidgetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan serverWidgetModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.update(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *serverWidgetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state serverWidgetModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, diags := r.read(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func editServerWidget(c *discordgo.Session, ctx context.Context, serverId string, enabled bool, channelId string) error {
+	settings := map[string]interface{}{
+		"enabled":    enabled,
+		"channel_id": nil,
+	}
+	if channelId != "" {
+		settings["channel_id"] = channelId
+	}
+
+	endpoint := discordgo.EndpointGuildWidget(serverId)
+	_, err := c.RequestWithBucketID(http.MethodPatch, endpoint, settings, endpoint, discordgo.WithContext(ctx))
+
+	return err
+}
+
+func (r *serverWidgetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan serverWidgetModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.update(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// update applies the widget in plan, and refreshes plan from it.
+func (r *serverWidgetResource) update(ctx context.Context, plan *serverWidgetModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	serverId := plan.ServerId.ValueString()
+	if err := editServerWidget(r.client, ctx, serverId, plan.Enabled.ValueBool(), plan.ChannelId.ValueString()); err != nil {
+		diags.AddError(fmt.Sprintf("Failed to update widget of server %s", serverId), err.Error())
+		return diags
+	}
+
+	_, readDiags := r.read(ctx, plan)
+	diags.Append(readDiags...)
+
+	return diags
+}
+
+func (r *serverWidgetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state serverWidgetModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverId := state.ServerId.ValueString()
+	if err := editServerWidget(r.client, ctx, serverId, false, ""); err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to disable widget of server %s", serverId), err.Error())
+	}
+}
@@ -0,0 +1,50 @@
+package discord
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceDiscordServerWidget(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	testChannelID := os.Getenv("DISCORD_TEST_CHANNEL_ID")
+	if testServerID == "" || testChannelID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID and DISCORD_TEST_CHANNEL_ID envvars must be set for acceptance tests")
+	}
+	name := "discord_server_widget.example"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDiscordServerWidget(testServerID, testChannelID, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "server_id", testServerID),
+					resource.TestCheckResourceAttr(name, "enabled", "true"),
+					resource.TestCheckResourceAttr(name, "channel_id", testChannelID),
+					resource.TestCheckResourceAttrSet(name, "json_url"),
+					resource.TestCheckResourceAttrSet(name, "image_url"),
+				),
+			},
+			{
+				Config: testAccResourceDiscordServerWidget(testServerID, testChannelID, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceDiscordServerWidget(serverID string, channelID string, enabled bool) string {
+	return fmt.Sprintf(`
+	resource "discord_server_widget" "example" {
+	  server_id = "%[1]s"
+	  channel_id = "%[2]s"
+	  enabled = %[3]t
+	}`, serverID, channelID, enabled)
+}
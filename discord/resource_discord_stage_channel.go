@@ -0,0 +1,30 @@
+package discord
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+)
+
+func resourceDiscordStageChannel() resource.Resource {
+	return &channelResource{
+		channelType: "stage",
+		description: "A resource to create a stage channel.",
+		attributes: map[string]schema.Attribute{
+			"bitrate": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(64000),
+				Description: "Bitrate of the channel.",
+			},
+			"user_limit": schema.Int64Attribute{
+				Optional:    true,
+				Description: "User limit of the channel.",
+			},
+			"rtc_region": schema.StringAttribute{
+				Optional:    true,
+				Description: "Voice region of the channel. Discord picks one automatically when not set.",
+			},
+		},
+	}
+}
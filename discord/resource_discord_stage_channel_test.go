@@ -0,0 +1,49 @@
+package discord
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceDiscordStageChannel(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	if testServerID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID envvar must be set for acceptance tests")
+	}
+	name := "discord_stage_channel.example"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDiscordStageChannel(testServerID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "server_id", testServerID),
+					resource.TestCheckResourceAttr(name, "name", "Terraform Stage Channel"),
+					resource.TestCheckResourceAttr(name, "type", "stage"),
+					resource.TestCheckResourceAttr(name, "position", "1"),
+					resource.TestCheckResourceAttrSet(name, "channel_id"),
+					resource.TestCheckResourceAttr(name, "bitrate", "64000"),
+					resource.TestCheckResourceAttr(name, "user_limit", "100"),
+					resource.TestCheckResourceAttr(name, "rtc_region", "rotterdam"),
+					resource.TestCheckResourceAttr(name, "sync_perms_with_category", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceDiscordStageChannel(serverID string) string {
+	return fmt.Sprintf(`
+	resource "discord_stage_channel" "example" {
+	  server_id = "%[1]s"
+      name = "Terraform Stage Channel"
+      position = 1
+      user_limit = 100
+      rtc_region = "rotterdam"
+      sync_perms_with_category = false
+	}`, serverID)
+}
@@ -0,0 +1,209 @@
+package discord
+
+import (
+	"fmt"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/net/context"
+)
+
+type stageInstanceResource struct {
+	frameworkResource
+}
+
+type stageInstanceModel struct {
+	ChannelId             types.String `tfsdk:"channel_id"`
+	Topic                 types.String `tfsdk:"topic"`
+	PrivacyLevel          types.String `tfsdk:"privacy_level"`
+	SendStartNotification types.Bool   `tfsdk:"send_start_notification"`
+	ServerId              types.String `tfsdk:"server_id"`
+	StageInstanceId       types.String `tfsdk:"stage_instance_id"`
+	Id                    types.String `tfsdk:"id"`
+}
+
+func resourceDiscordStageInstance() resource.Resource {
+	return &stageInstanceResource{}
+}
+
+func (r *stageInstanceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stage_instance"
+}
+
+func (r *stageInstanceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// Version 1 moved the resource from the SDK to
+		// terraform-plugin-framework.
+		Version:     1,
+		Description: "A resource to start a stage in a stage channel.",
+		Attributes: map[string]schema.Attribute{
+			"channel_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "ID of the stage channel.",
+			},
+			"topic": schema.StringAttribute{
+				Required:    true,
+				Validators:  []validator.String{stringvalidator.LengthBetween(1, 120)},
+				Description: "Topic of the stage.",
+			},
+			"privacy_level": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("guild_only"),
+				Validators:  []validator.String{stringvalidator.OneOf("public", "guild_only")},
+				Description: "Who can see the stage, either `guild_only` or the deprecated `public`.",
+			},
+			"send_start_notification": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether `@everyone` is notified when the stage starts. Only used when the stage is started.",
+			},
+			"server_id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "ID of the server the stage is in.",
+			},
+			"stage_instance_id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The ID of the stage instance.",
+			},
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The ID of the stage channel.",
+			},
+		},
+	}
+}
+
+func (r *stageInstanceResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
+
+	return map[int64]resource.StateUpgrader{0: upgradeFromSDK(resp.Schema)}
+}
+
+func (r *stageInstanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+var stagePrivacyLevels = map[string]discordgo.StageInstancePrivacyLevel{
+	"public":     discordgo.StageInstancePrivacyLevelPublic,
+	"guild_only": discordgo.StageInstancePrivacyLevelGuildOnly,
+}
+
+func getTextStagePrivacyLevel(level discordgo.StageInstancePrivacyLevel) string {
+	for k, v := range stagePrivacyLevels {
+		if v == level {
+			return k
+		}
+	}
+
+	return "guild_only"
+}
+
+func (r *stageInstanceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan stageInstanceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channelId := plan.ChannelId.ValueString()
+	instance, err := r.client.StageInstanceCreate(&discordgo.StageInstanceParams{
+		ChannelID:             channelId,
+		Topic:                 plan.Topic.ValueString(),
+		PrivacyLevel:          stagePrivacyLevels[plan.PrivacyLevel.ValueString()],
+		SendStartNotification: plan.SendStartNotification.ValueBool(),
+	}, discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to start stage in channel %s", channelId), err.Error())
+		return
+	}
+
+	// Stage instances are addressed by the ID of their channel.
+	plan.Id = types.StringValue(instance.ChannelID)
+	plan.ServerId = types.StringValue(instance.GuildID)
+	plan.StageInstanceId = types.StringValue(instance.ID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *stageInstanceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state stageInstanceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := r.client.StageInstance(state.Id.ValueString(), discordgo.WithContext(ctx))
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to fetch stage instance %s", state.Id.ValueString()), err.Error())
+		return
+	}
+
+	state.ChannelId = types.StringValue(instance.ChannelID)
+	state.ServerId = types.StringValue(instance.GuildID)
+	state.StageInstanceId = types.StringValue(instance.ID)
+	state.Topic = types.StringValue(instance.Topic)
+	state.PrivacyLevel = types.StringValue(getTextStagePrivacyLevel(instance.PrivacyLevel))
+	// Whether the start was notified isn't returned.
+	if state.SendStartNotification.IsNull() {
+		state.SendStartNotification = types.BoolValue(false)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *stageInstanceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state stageInstanceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Topic.Equal(state.Topic) || !plan.PrivacyLevel.Equal(state.PrivacyLevel) {
+		instance, err := r.client.StageInstanceEdit(plan.Id.ValueString(), &discordgo.StageInstanceParams{
+			Topic:        plan.Topic.ValueString(),
+			PrivacyLevel: stagePrivacyLevels[plan.PrivacyLevel.ValueString()],
+		}, discordgo.WithContext(ctx))
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to update stage in channel %s", plan.Id.ValueString()), err.Error())
+			return
+		}
+
+		plan.Topic = types.StringValue(instance.Topic)
+		plan.PrivacyLevel = types.StringValue(getTextStagePrivacyLevel(instance.PrivacyLevel))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *stageInstanceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state stageInstanceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.StageInstanceDelete(state.Id.ValueString(), discordgo.WithContext(ctx)); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to end stage in channel %s", state.Id.ValueString()), err.Error())
+	}
+}
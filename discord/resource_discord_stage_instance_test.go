@@ -0,0 +1,53 @@
+package discord
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceDiscordStageInstance(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	if testServerID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID envvar must be set for acceptance tests")
+	}
+	name := "discord_stage_instance.example"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDiscordStageInstance(testServerID, "Town hall"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "channel_id", "discord_stage_channel.example", "id"),
+					resource.TestCheckResourceAttr(name, "server_id", testServerID),
+					resource.TestCheckResourceAttr(name, "topic", "Town hall"),
+					resource.TestCheckResourceAttr(name, "privacy_level", "guild_only"),
+					resource.TestCheckResourceAttrSet(name, "stage_instance_id"),
+				),
+			},
+			{
+				Config: testAccResourceDiscordStageInstance(testServerID, "Town hall Q&A"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "topic", "Town hall Q&A"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceDiscordStageInstance(serverID string, topic string) string {
+	return fmt.Sprintf(`
+	resource "discord_stage_channel" "example" {
+	  server_id = "%[1]s"
+      name = "Terraform Stage Instance"
+      sync_perms_with_category = false
+	}
+
+	resource "discord_stage_instance" "example" {
+	  channel_id = discord_stage_channel.example.id
+      topic = "%[2]s"
+	}`, serverID, topic)
+}
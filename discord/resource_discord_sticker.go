@@ -0,0 +1,268 @@
+package discord
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/net/context"
+)
+
+type stickerResource struct {
+	frameworkResource
+}
+
+type stickerModel struct {
+	ServerId    types.String `tfsdk:"server_id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Tags        types.String `tfsdk:"tags"`
+	File        types.String `tfsdk:"file"`
+	FormatType  types.String `tfsdk:"format_type"`
+	Available   types.Bool   `tfsdk:"available"`
+	Id          types.String `tfsdk:"id"`
+}
+
+func resourceDiscordSticker() resource.Resource {
+	return &stickerResource{}
+}
+
+func (r *stickerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sticker"
+}
+
+func (r *stickerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// Version 1 moved the resource from the SDK to
+		// terraform-plugin-framework.
+		Version:     1,
+		Description: "A resource to create a custom sticker in a server.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "ID of the server the sticker is in.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Validators:  []validator.String{stringvalidator.LengthBetween(2, 30)},
+				Description: "Name of the sticker.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.LengthBetween(2, 100)},
+				Description: "Description of the sticker.",
+			},
+			"tags": schema.StringAttribute{
+				Required:    true,
+				Validators:  []validator.String{stringvalidator.LengthBetween(1, 200)},
+				Description: "Name of a unicode emoji related to the sticker, used for autocompletion.",
+			},
+			"file": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "Path to a local PNG, APNG, GIF or Lottie JSON file to upload as the sticker. The file can't be changed once the sticker is created.",
+			},
+			"format_type": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "Format of the sticker, one of `png`, `apng`, `lottie` or `gif`.",
+			},
+			"available": schema.BoolAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+				Description:   "Whether the sticker can be used. Stickers become unavailable when the server loses boosts.",
+			},
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The ID of the sticker.",
+			},
+		},
+	}
+}
+
+func (r *stickerResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
+
+	return map[int64]resource.StateUpgrader{0: upgradeFromSDK(resp.Schema)}
+}
+
+func (r *stickerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	serverId, stickerId, err := parseTwoIds(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import sticker", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), stickerId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), serverId)...)
+}
+
+var stickerFormats = map[string]discordgo.StickerFormat{
+	"png":    discordgo.StickerFormatTypePNG,
+	"apng":   discordgo.StickerFormatTypeAPNG,
+	"lottie": discordgo.StickerFormatTypeLottie,
+	"gif":    discordgo.StickerFormatTypeGIF,
+}
+
+var stickerContentTypes = map[string]string{
+	".png":  "image/png",
+	".apng": "image/png",
+	".gif":  "image/gif",
+	".json": "application/json",
+}
+
+func getTextStickerFormat(format discordgo.StickerFormat) string {
+	for k, v := range stickerFormats {
+		if v == format {
+			return k
+		}
+	}
+
+	return ""
+}
+
+func setSticker(m *stickerModel, sticker *discordgo.Sticker) {
+	m.Name = types.StringValue(sticker.Name)
+	m.Description = stringValue(sticker.Description, m.Description)
+	m.Tags = types.StringValue(sticker.Tags)
+	m.FormatType = types.StringValue(getTextStickerFormat(sticker.FormatType))
+	m.Available = types.BoolValue(sticker.Available)
+}
+
+func (r *stickerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan stickerModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverId := plan.ServerId.ValueString()
+	filename := plan.File.ValueString()
+	file, err := os.Open(filename)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to process %s", filename), err.Error())
+		return
+	}
+	defer file.Close()
+
+	body, err := requestMultipart(ctx, r.client, http.MethodPost, discordgo.EndpointGuildStickers(serverId), map[string]string{
+		"name":        plan.Name.ValueString(),
+		"description": plan.Description.ValueString(),
+		"tags":        plan.Tags.ValueString(),
+	}, &discordgo.File{
+		Name:        filepath.Base(filename),
+		ContentType: stickerContentTypes[strings.ToLower(filepath.Ext(filename))],
+		Reader:      file,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to create sticker in %s", serverId), err.Error())
+		return
+	}
+
+	var sticker discordgo.Sticker
+	if err := json.Unmarshal(body, &sticker); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to create sticker in %s", serverId), err.Error())
+		return
+	}
+
+	plan.Id = types.StringValue(sticker.ID)
+	plan.FormatType = types.StringValue(getTextStickerFormat(sticker.FormatType))
+	plan.Available = types.BoolValue(sticker.Available)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// read refreshes m with its sticker, and reports whether the sticker still
+// exists.
+func (r *stickerResource) read(ctx context.Context, m *stickerModel) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	endpoint := discordgo.EndpointGuildSticker(m.ServerId.ValueString(), m.Id.ValueString())
+	body, err := r.client.RequestWithBucketID(http.MethodGet, endpoint, nil, endpoint, discordgo.WithContext(ctx))
+	if err != nil {
+		if isNotFound(err) {
+			return false, diags
+		}
+
+		diags.AddError(fmt.Sprintf("Failed to fetch sticker %s", m.Id.ValueString()), err.Error())
+		return true, diags
+	}
+
+	var sticker discordgo.Sticker
+	if err := json.Unmarshal(body, &sticker); err != nil {
+		diags.AddError(fmt.Sprintf("Failed to fetch sticker %s", m.Id.ValueString()), err.Error())
+		return true, diags
+	}
+
+	setSticker(m, &sticker)
+
+	return true, diags
+}
+
+func (r *stickerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state stickerModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, diags := r.read(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *stickerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan stickerModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := discordgo.EndpointGuildSticker(plan.ServerId.ValueString(), plan.Id.ValueString())
+	if _, err := r.client.RequestWithBucketID(http.MethodPatch, endpoint, map[string]interface{}{
+		"name":        plan.Name.ValueString(),
+		"description": plan.Description.ValueString(),
+		"tags":        plan.Tags.ValueString(),
+	}, endpoint, discordgo.WithContext(ctx)); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to update sticker %s", plan.Id.ValueString()), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *stickerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state stickerModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := discordgo.EndpointGuildSticker(state.ServerId.ValueString(), state.Id.ValueString())
+	if _, err := r.client.RequestWithBucketID(http.MethodDelete, endpoint, nil, endpoint, discordgo.WithContext(ctx)); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to delete sticker %s", state.Id.ValueString()), err.Error())
+	}
+}
@@ -0,0 +1,78 @@
+package discord
+
+import (
+	"fmt"
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceDiscordSticker(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	if testServerID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID envvar must be set for acceptance tests")
+	}
+	file := testAccStickerFile(t)
+	name := "discord_sticker.example"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDiscordSticker(testServerID, file, "terraform-sticker"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "server_id", testServerID),
+					resource.TestCheckResourceAttr(name, "name", "terraform-sticker"),
+					resource.TestCheckResourceAttr(name, "description", "Uploaded by Terraform"),
+					resource.TestCheckResourceAttr(name, "tags", "wave"),
+					resource.TestCheckResourceAttr(name, "format_type", "png"),
+					resource.TestCheckResourceAttr(name, "available", "true"),
+				),
+			},
+			{
+				Config: testAccResourceDiscordSticker(testServerID, file, "terraform-sticker-renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", "terraform-sticker-renamed"),
+					resource.TestCheckResourceAttrPair("data.discord_sticker.example", "id", name, "id"),
+				),
+			},
+		},
+	})
+}
+
+// testAccStickerFile writes a blank 320x320 PNG, the size Discord requires for
+// stickers, and returns its path.
+func testAccStickerFile(t *testing.T) string {
+	path := filepath.Join(t.TempDir(), "sticker.png")
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	if err := png.Encode(file, image.NewRGBA(image.Rect(0, 0, 320, 320))); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func testAccResourceDiscordSticker(serverID string, file string, name string) string {
+	return fmt.Sprintf(`
+	resource "discord_sticker" "example" {
+	  server_id = "%[1]s"
+      name = "%[3]s"
+      description = "Uploaded by Terraform"
+      tags = "wave"
+      file = "%[2]s"
+	}
+
+	data "discord_sticker" "example" {
+	  server_id = discord_sticker.example.server_id
+	  name = discord_sticker.example.name
+	}`, serverID, file, name)
+}
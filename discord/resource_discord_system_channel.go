@@ -1,123 +1,143 @@
 package discord
 
 import (
+	"fmt"
+
 	"github.com/bwmarrin/discordgo"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"golang.org/x/net/context"
 )
 
-func resourceDiscordSystemChannel() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: resourceSystemChannelCreate,
-		ReadContext:   resourceSystemChannelRead,
-		UpdateContext: resourceSystemChannelUpdate,
-		DeleteContext: resourceSystemChannelDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+type systemChannelResource struct {
+	frameworkResource
+}
+
+type systemChannelModel struct {
+	ServerId        types.String `tfsdk:"server_id"`
+	SystemChannelId types.String `tfsdk:"system_channel_id"`
+	Id              types.String `tfsdk:"id"`
+}
+
+func resourceDiscordSystemChannel() resource.Resource {
+	return &systemChannelResource{}
+}
 
+func (r *systemChannelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_system_channel"
+}
+
+func (r *systemChannelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// Version 1 moved the resource from the SDK to
+		// terraform-plugin-framework.
+		Version:     1,
 		Description: "Manage the system channel of a Discord server.",
-		Schema: map[string]*schema.Schema{
-			"server_id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "The ID of the server to manage the system channel for.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "The ID of the server to manage the system channel for.",
 			},
-			"system_channel_id": {
-				Type:        schema.TypeString,
+			"system_channel_id": schema.StringAttribute{
 				Required:    true,
 				Description: "The ID of the channel that will be used as the system channel.",
 			},
-			"id": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "The ID of the server.",
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The ID of the server.",
 			},
 		},
 	}
 }
 
-func resourceSystemChannelCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	client := m.(*Context).Session
+func (r *systemChannelResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
 
-	serverId := d.Get("server_id").(string)
-
-	server, err := client.Guild(serverId, discordgo.WithContext(ctx))
-	if err != nil {
-		return diag.Errorf("Failed to find server: %s", err.Error())
-	}
+	return map[int64]resource.StateUpgrader{0: upgradeFromSDK(resp.Schema)}
+}
 
-	systemChannelId := server.SystemChannelID
-	if v, ok := d.GetOk("system_channel_id"); ok {
-		systemChannelId = v.(string)
+func (r *systemChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), req.ID)...)
+}
 
-	} else {
-		return diag.Errorf("Failed to parse system channel id")
+func (r *systemChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan systemChannelModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	if _, err := client.GuildEdit(serverId, &discordgo.GuildParams{
-		SystemChannelID: systemChannelId,
+
+	serverId := plan.ServerId.ValueString()
+	if _, err := r.client.GuildEdit(serverId, &discordgo.GuildParams{
+		SystemChannelID: plan.SystemChannelId.ValueString(),
 	}, discordgo.WithContext(ctx)); err != nil {
-		return diag.Errorf("Failed to edit server: %s", err.Error())
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to edit server %s", serverId), err.Error())
+		return
 	}
 
-	d.SetId(serverId)
-
-	return diags
+	plan.Id = types.StringValue(serverId)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func resourceSystemChannelRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	client := m.(*Context).Session
-
-	serverId := d.Id()
+func (r *systemChannelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state systemChannelModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	server, err := client.Guild(serverId, discordgo.WithContext(ctx))
+	server, err := r.client.Guild(state.Id.ValueString(), discordgo.WithContext(ctx))
 	if err != nil {
-		return diag.Errorf("Error fetching server: %s", err.Error())
-	}
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 
-	d.Set("system_channel_id", server.SystemChannelID)
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to fetch server %s", state.Id.ValueString()), err.Error())
+		return
+	}
 
-	return diags
+	state.SystemChannelId = types.StringValue(server.SystemChannelID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
-func resourceSystemChannelUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	client := m.(*Context).Session
-
-	serverId := d.Get("server_id").(string)
-	_, err := client.Guild(serverId, discordgo.WithContext(ctx))
-
-	if err != nil {
-		return diag.Errorf("Error fetching server: %s", err.Error())
+func (r *systemChannelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan systemChannelModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if d.HasChange("system_channel_id") {
-		id := d.Get("system_channel_id").(string)
-		if _, err := client.GuildEdit(serverId, &discordgo.GuildParams{
-			SystemChannelID: id,
-		}, discordgo.WithContext(ctx)); err != nil {
-			return diag.Errorf("Failed to edit server: %s", err.Error())
-		}
+	serverId := plan.ServerId.ValueString()
+	if _, err := r.client.GuildEdit(serverId, &discordgo.GuildParams{
+		SystemChannelID: plan.SystemChannelId.ValueString(),
+	}, discordgo.WithContext(ctx)); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to edit server %s", serverId), err.Error())
+		return
 	}
 
-	return diags
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func resourceSystemChannelDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	client := m.(*Context).Session
-
-	serverID := d.Get("server_id").(string)
+func (r *systemChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state systemChannelModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	if _, err := client.GuildEdit(serverID, &discordgo.GuildParams{
+	serverId := state.ServerId.ValueString()
+	if _, err := r.client.GuildEdit(serverId, &discordgo.GuildParams{
 		SystemChannelID: "",
 	}, discordgo.WithContext(ctx)); err != nil {
-		return diag.Errorf("Failed to edit server: %s: %s", serverID, err.Error())
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to edit server %s", serverId), err.Error())
 	}
-
-	return diags
 }
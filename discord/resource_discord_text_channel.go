@@ -1,31 +1,52 @@
 package discord
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
-func resourceDiscordTextChannel() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: resourceChannelCreate,
-		ReadContext:   resourceChannelRead,
-		UpdateContext: resourceChannelUpdate,
-		DeleteContext: resourceChannelDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-		Description: "A resource to create a text channel.",
-		Schema: getChannelSchema("text", map[string]*schema.Schema{
-			"topic": {
-				Type:        schema.TypeString,
+func resourceDiscordTextChannel() resource.Resource {
+	return &channelResource{
+		channelType: "text",
+		description: "A resource to create a text channel.",
+		attributes: map[string]schema.Attribute{
+			"topic": schema.StringAttribute{
 				Optional:    true,
 				Description: "Topic of the channel.",
 			},
-			"nsfw": {
-				Type:        schema.TypeBool,
+			"nsfw": schema.BoolAttribute{
 				Optional:    true,
-				Default:     false,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
 				Description: "Whether the channel is NSFW.",
 			},
-		}),
+			"rate_limit_per_user": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Validators:  []validator.Int64{int64validator.Between(0, 21600)},
+				Description: "Slowmode in seconds of the channel.",
+			},
+			"default_auto_archive_duration": schema.Int64Attribute{
+				Optional:      true,
+				Computed:      true,
+				Validators:    []validator.Int64{int64validator.OneOf(60, 1440, 4320, 10080)},
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Description:   "Minutes of inactivity after which new threads of the channel are archived, one of `60`, `1440`, `4320` or `10080`.",
+			},
+			"default_thread_rate_limit_per_user": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Validators:  []validator.Int64{int64validator.Between(0, 21600)},
+				Description: "Slowmode in seconds applied to new threads of the channel.",
+			},
+		},
 	}
 }
@@ -15,8 +15,8 @@ func TestAccResourceDiscordTextChannel(t *testing.T) {
 	}
 	name := "discord_text_channel.example"
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceDiscordTextChannel(testServerID),
@@ -28,6 +28,9 @@ func TestAccResourceDiscordTextChannel(t *testing.T) {
 					resource.TestCheckResourceAttrSet(name, "channel_id"),
 					resource.TestCheckResourceAttr(name, "topic", "Testing text channel"),
 					resource.TestCheckResourceAttr(name, "nsfw", "false"),
+					resource.TestCheckResourceAttr(name, "rate_limit_per_user", "30"),
+					resource.TestCheckResourceAttr(name, "default_auto_archive_duration", "4320"),
+					resource.TestCheckResourceAttr(name, "default_thread_rate_limit_per_user", "10"),
 					resource.TestCheckResourceAttr(name, "sync_perms_with_category", "false"),
 				),
 			},
@@ -43,6 +46,9 @@ func testAccResourceDiscordTextChannel(serverID string) string {
       position = 1
       topic = "Testing text channel"
       nsfw = false
+      rate_limit_per_user = 30
+      default_auto_archive_duration = 4320
+      default_thread_rate_limit_per_user = 10
       sync_perms_with_category = false
 	}`, serverID)
 }
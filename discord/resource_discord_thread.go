@@ -0,0 +1,502 @@
+package discord
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/net/context"
+)
+
+type threadResource struct {
+	frameworkResource
+}
+
+type threadModel struct {
+	ChannelId           types.String         `tfsdk:"channel_id"`
+	ServerId            types.String         `tfsdk:"server_id"`
+	Name                types.String         `tfsdk:"name"`
+	Type                types.String         `tfsdk:"type"`
+	AutoArchiveDuration types.Int64          `tfsdk:"auto_archive_duration"`
+	Archived            types.Bool           `tfsdk:"archived"`
+	Locked              types.Bool           `tfsdk:"locked"`
+	Invitable           types.Bool           `tfsdk:"invitable"`
+	RateLimitPerUser    types.Int64          `tfsdk:"rate_limit_per_user"`
+	AppliedTags         types.Set            `tfsdk:"applied_tags"`
+	Message             []threadMessageModel `tfsdk:"message"`
+	Id                  types.String         `tfsdk:"id"`
+}
+
+type threadMessageModel struct {
+	Content types.String `tfsdk:"content"`
+	Embed   *embedModel  `tfsdk:"embed"`
+}
+
+func resourceDiscordThread() resource.Resource {
+	return &threadResource{}
+}
+
+func (r *threadResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_thread"
+}
+
+func (r *threadResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// Version 1 moved the resource from the SDK to
+		// terraform-plugin-framework, and turned the single item lists of
+		// the embed of the starter message into nested attributes.
+		Version:     1,
+		Description: "A resource to create a thread in a text, news or forum channel.",
+		Attributes: map[string]schema.Attribute{
+			"channel_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "ID of the channel to create the thread in.",
+			},
+			"server_id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "ID of the server the thread is in.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Validators:  []validator.String{stringvalidator.LengthBetween(1, 100)},
+				Description: "Name of the thread.",
+			},
+			"type": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Default:       stringdefault.StaticString("public"),
+				Validators:    []validator.String{stringvalidator.OneOf("public", "private", "news")},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "Type of the thread, one of `public`, `private` or `news`. Threads in forum channels are always `public`.",
+			},
+			"auto_archive_duration": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(10080),
+				Validators:  []validator.Int64{int64validator.OneOf(60, 1440, 4320, 10080)},
+				Description: "Minutes of inactivity after which the thread is archived, one of `60`, `1440`, `4320` or `10080`.",
+			},
+			"archived": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the thread is archived. Archived threads are unarchived on the next apply unless this is set.",
+			},
+			"locked": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether only moderators can unarchive the thread.",
+			},
+			"invitable": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether members who aren't moderators can invite others to the thread. Only used by private threads.",
+			},
+			"rate_limit_per_user": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Validators:  []validator.Int64{int64validator.Between(0, 21600)},
+				Description: "Slowmode of the thread in seconds.",
+			},
+			"applied_tags": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators:  []validator.Set{setvalidator.SizeAtMost(5)},
+				Description: "IDs of the forum tags applied to the thread. Only allowed in forum channels.",
+			},
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The ID of the thread.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"message": schema.ListNestedBlock{
+				Validators:  []validator.List{listvalidator.SizeAtMost(1)},
+				Description: "The starter message of the thread. Required in forum channels and not allowed elsewhere.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"content": schema.StringAttribute{
+							Optional:    true,
+							Description: "Text content of the message. At least one of `content` or `embed` must be set.",
+						},
+						"embed": getEmbedAttribute("An embed. At least one of `content` or `embed` must be set."),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *threadResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {StateUpgrader: upgradeThreadStateV0},
+	}
+}
+
+// threadStateV0 is the state of a thread from when the resource was built on
+// the SDK, when the embed of the starter message was a list.
+type threadStateV0 struct {
+	ChannelId           string   `json:"channel_id"`
+	ServerId            string   `json:"server_id"`
+	Name                string   `json:"name"`
+	Type                string   `json:"type"`
+	AutoArchiveDuration int64    `json:"auto_archive_duration"`
+	Archived            bool     `json:"archived"`
+	Locked              bool     `json:"locked"`
+	Invitable           bool     `json:"invitable"`
+	RateLimitPerUser    int64    `json:"rate_limit_per_user"`
+	AppliedTags         []string `json:"applied_tags"`
+	Message             []struct {
+		Content string          `json:"content"`
+		Embed   []UnmappedEmbed `json:"embed"`
+	} `json:"message"`
+	Id string `json:"id"`
+}
+
+func upgradeThreadStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior threadStateV0
+	if err := json.Unmarshal(req.RawState.JSON, &prior); err != nil {
+		resp.Diagnostics.AddError("Failed to upgrade the state of the thread", err.Error())
+		return
+	}
+
+	appliedTags, diags := setValue(ctx, prior.AppliedTags, types.SetNull(types.StringType))
+	resp.Diagnostics.Append(diags...)
+	state := threadModel{
+		ChannelId:           types.StringValue(prior.ChannelId),
+		ServerId:            types.StringValue(prior.ServerId),
+		Name:                types.StringValue(prior.Name),
+		Type:                types.StringValue(prior.Type),
+		AutoArchiveDuration: types.Int64Value(prior.AutoArchiveDuration),
+		Archived:            types.BoolValue(prior.Archived),
+		Locked:              types.BoolValue(prior.Locked),
+		Invitable:           types.BoolValue(prior.Invitable),
+		RateLimitPerUser:    types.Int64Value(prior.RateLimitPerUser),
+		AppliedTags:         appliedTags,
+		Id:                  types.StringValue(prior.Id),
+	}
+	state.Message = make([]threadMessageModel, 0, len(prior.Message))
+	for _, m := range prior.Message {
+		message := threadMessageModel{
+			Content: stringValue(m.Content, types.StringNull()),
+		}
+		if len(m.Embed) > 0 {
+			message.Embed = flattenEmbed(mapEmbed(m.Embed[0]), nil)
+		}
+		state.Message = append(state.Message, message)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *threadResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// ModifyPlan rejects news threads outside of news channels, which Discord
+// only notices once the thread is created.
+func (r *threadResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan threadModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.Type.IsUnknown() || plan.ChannelId.IsUnknown() || plan.Type.ValueString() != "news" {
+		return
+	}
+	// Both require the thread to be replaced, so the parent only has to be
+	// checked when they change.
+	if !req.State.Raw.IsNull() {
+		var state threadModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() || (plan.Type.Equal(state.Type) && plan.ChannelId.Equal(state.ChannelId)) {
+			return
+		}
+	}
+
+	channelId := plan.ChannelId.ValueString()
+	parent, err := r.client.Channel(channelId, discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to fetch channel %s", channelId), err.Error())
+		return
+	}
+	if parent.Type != discordgo.ChannelTypeGuildNews {
+		resp.Diagnostics.AddAttributeError(path.Root("type"), "Invalid Attribute Value", "news threads can only be created in news channels")
+	}
+}
+
+// buildThreadMessage returns the content and the embeds of the starter
+// message m.
+func buildThreadMessage(m *threadMessageModel) (string, []*discordgo.MessageEmbed) {
+	return m.Content.ValueString(), buildEmbeds(m.Embed)
+}
+
+// setThreadMessageComputed fills in the values of the embed of m that are
+// only known once message was sent or edited.
+func setThreadMessageComputed(m *threadMessageModel, message *discordgo.Message) {
+	if m.Embed == nil {
+		return
+	}
+
+	embed := &discordgo.MessageEmbed{}
+	if len(message.Embeds) > 0 {
+		embed = message.Embeds[0]
+	}
+	setEmbedComputed(m.Embed, embed)
+}
+
+func (r *threadResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan threadModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channelId := plan.ChannelId.ValueString()
+	parent, err := r.client.Channel(channelId, discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to fetch channel %s", channelId), err.Error())
+		return
+	}
+
+	appliedTags, _ := setStrings(ctx, plan.AppliedTags)
+	data := &discordgo.ThreadStart{
+		Name:                plan.Name.ValueString(),
+		AutoArchiveDuration: int(plan.AutoArchiveDuration.ValueInt64()),
+		RateLimitPerUser:    int(plan.RateLimitPerUser.ValueInt64()),
+	}
+
+	var thread *discordgo.Channel
+	if isForumChannel(parent) {
+		if len(plan.Message) == 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("message"), "Missing Attribute Configuration", fmt.Sprintf("message must be set to create a thread in forum channel %s", channelId))
+			return
+		}
+		content, embeds := buildThreadMessage(&plan.Message[0])
+		data.AppliedTags = appliedTags
+
+		thread, err = r.client.ForumThreadStartComplex(channelId, data, &discordgo.MessageSend{
+			Content: content,
+			Embeds:  embeds,
+		}, discordgo.WithContext(ctx))
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to create thread in %s", channelId), err.Error())
+			return
+		}
+
+		// The starter message of a forum thread shares its ID with the thread.
+		if plan.Message[0].Embed != nil {
+			message, err := r.client.ChannelMessage(thread.ID, thread.ID, discordgo.WithContext(ctx))
+			if err != nil {
+				resp.Diagnostics.AddError(fmt.Sprintf("Failed to fetch starter message of thread %s", thread.ID), err.Error())
+				return
+			}
+			setThreadMessageComputed(&plan.Message[0], message)
+		}
+	} else {
+		if len(plan.Message) > 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("message"), "Invalid Attribute Combination", "message can only be set on threads in forum channels")
+			return
+		}
+		if len(appliedTags) > 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("applied_tags"), "Invalid Attribute Combination", "applied_tags can only be set on threads in forum channels")
+			return
+		}
+		data.Type = threadTypes[plan.Type.ValueString()]
+		data.Invitable = plan.Invitable.ValueBool()
+
+		thread, err = r.client.ThreadStartComplex(channelId, data, discordgo.WithContext(ctx))
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to create thread in %s", channelId), err.Error())
+			return
+		}
+	}
+
+	plan.Id = types.StringValue(thread.ID)
+	plan.ServerId = types.StringValue(thread.GuildID)
+
+	// Threads can't be created locked or archived.
+	if plan.Locked.ValueBool() || plan.Archived.ValueBool() {
+		if _, err := r.client.ChannelEditComplex(thread.ID, &discordgo.ChannelEdit{
+			Locked:   BoolPtr(plan.Locked.ValueBool()),
+			Archived: BoolPtr(plan.Archived.ValueBool()),
+		}, discordgo.WithContext(ctx)); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to update thread %s", thread.ID), err.Error())
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *threadResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state threadModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	threadId := state.Id.ValueString()
+	thread, err := r.client.Channel(threadId, discordgo.WithContext(ctx))
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to fetch thread %s", threadId), err.Error())
+		return
+	}
+
+	threadType, ok := getTextThreadType(thread.Type)
+	if !ok {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to fetch thread %s", threadId), fmt.Sprintf("channel %s is not a thread", threadId))
+		return
+	}
+
+	state.ChannelId = types.StringValue(thread.ParentID)
+	state.ServerId = types.StringValue(thread.GuildID)
+	state.Name = types.StringValue(thread.Name)
+	state.Type = types.StringValue(threadType)
+	state.RateLimitPerUser = types.Int64Value(int64(thread.RateLimitPerUser))
+	appliedTags, diags := setValue(ctx, thread.AppliedTags, state.AppliedTags)
+	resp.Diagnostics.Append(diags...)
+	state.AppliedTags = appliedTags
+	if thread.ThreadMetadata != nil {
+		state.AutoArchiveDuration = types.Int64Value(int64(thread.ThreadMetadata.AutoArchiveDuration))
+		state.Archived = types.BoolValue(thread.ThreadMetadata.Archived)
+		state.Locked = types.BoolValue(thread.ThreadMetadata.Locked)
+		if threadType == "private" {
+			state.Invitable = types.BoolValue(thread.ThreadMetadata.Invitable)
+		}
+	}
+	// Only private threads return whether they are invitable.
+	if state.Invitable.IsNull() {
+		state.Invitable = types.BoolValue(true)
+	}
+
+	parent, err := r.client.Channel(thread.ParentID, discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to fetch channel %s", thread.ParentID), err.Error())
+		return
+	}
+	if isForumChannel(parent) {
+		// The starter message of a forum thread shares its ID with the thread.
+		message, err := r.client.ChannelMessage(thread.ID, thread.ID, discordgo.WithContext(ctx))
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to fetch starter message of thread %s", thread.ID), err.Error())
+			return
+		}
+
+		var prior threadMessageModel
+		if len(state.Message) > 0 {
+			prior = state.Message[0]
+		}
+		starter := threadMessageModel{
+			Content: stringValue(message.Content, prior.Content),
+		}
+		if len(message.Embeds) > 0 {
+			starter.Embed = flattenEmbed(message.Embeds[0], prior.Embed)
+		}
+		state.Message = []threadMessageModel{starter}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *threadResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state threadModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	threadId := plan.Id.ValueString()
+	edit := &discordgo.ChannelEdit{
+		Name:                plan.Name.ValueString(),
+		AutoArchiveDuration: int(plan.AutoArchiveDuration.ValueInt64()),
+		RateLimitPerUser:    IntPtr(int(plan.RateLimitPerUser.ValueInt64())),
+	}
+	if !plan.Archived.Equal(state.Archived) {
+		edit.Archived = BoolPtr(plan.Archived.ValueBool())
+	}
+	if !plan.Locked.Equal(state.Locked) {
+		edit.Locked = BoolPtr(plan.Locked.ValueBool())
+	}
+	if plan.Type.ValueString() == "private" {
+		edit.Invitable = BoolPtr(plan.Invitable.ValueBool())
+	}
+	if !plan.AppliedTags.Equal(state.AppliedTags) {
+		appliedTags, _ := setStrings(ctx, plan.AppliedTags)
+		edit.AppliedTags = &appliedTags
+	}
+
+	if _, err := r.client.ChannelEditComplex(threadId, edit, discordgo.WithContext(ctx)); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to update thread %s", threadId), err.Error())
+		return
+	}
+
+	// The message also differs from the state when values Discord computes
+	// for its embed are unknown in the plan.
+	var planMessage, stateMessage types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("message"), &planMessage)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("message"), &stateMessage)...)
+	if !planMessage.Equal(stateMessage) {
+		if len(plan.Message) == 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("message"), "Invalid Attribute Value", "message can't be removed from a thread in a forum channel")
+			return
+		}
+		content, embeds := buildThreadMessage(&plan.Message[0])
+
+		message, err := r.client.ChannelMessageEditComplex(&discordgo.MessageEdit{
+			ID:      threadId,
+			Channel: threadId,
+			Content: &content,
+			Embeds:  &embeds,
+		}, discordgo.WithContext(ctx))
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Failed to update starter message of thread %s", threadId), err.Error())
+			return
+		}
+		setThreadMessageComputed(&plan.Message[0], message)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *threadResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state threadModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	threadId := state.Id.ValueString()
+	if _, err := r.client.ChannelDelete(threadId, discordgo.WithContext(ctx)); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to delete thread %s", threadId), err.Error())
+	}
+}
@@ -0,0 +1,131 @@
+package discord
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceDiscordThread(t *testing.T) {
+	testChannelID := os.Getenv("DISCORD_TEST_CHANNEL_ID")
+	if testChannelID == "" {
+		t.Skip("DISCORD_TEST_CHANNEL_ID envvar must be set for acceptance tests")
+	}
+	name := "discord_thread.example"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDiscordThread(testChannelID, "terraform-thread"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "channel_id", testChannelID),
+					resource.TestCheckResourceAttrSet(name, "server_id"),
+					resource.TestCheckResourceAttr(name, "name", "terraform-thread"),
+					resource.TestCheckResourceAttr(name, "type", "private"),
+					resource.TestCheckResourceAttr(name, "auto_archive_duration", "1440"),
+					resource.TestCheckResourceAttr(name, "invitable", "false"),
+					resource.TestCheckResourceAttr(name, "rate_limit_per_user", "10"),
+					resource.TestCheckResourceAttr(name, "archived", "false"),
+				),
+			},
+			{
+				Config: testAccResourceDiscordThread(testChannelID, "terraform-thread-renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", "terraform-thread-renamed"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceDiscordThreadForum(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	if testServerID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID envvar must be set for acceptance tests")
+	}
+	name := "discord_thread.example"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDiscordThreadForum(testServerID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "channel_id", "discord_forum_channel.example", "id"),
+					resource.TestCheckResourceAttr(name, "name", "FAQ"),
+					resource.TestCheckResourceAttr(name, "type", "public"),
+					resource.TestCheckResourceAttr(name, "locked", "true"),
+					resource.TestCheckResourceAttr(name, "applied_tags.#", "1"),
+					resource.TestCheckResourceAttr(name, "message.0.content", "Read this first"),
+					resource.TestCheckResourceAttr(name, "message.0.embed.title", "FAQ"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceDiscordThreadNewsOutsideNewsChannel(t *testing.T) {
+	testChannelID := os.Getenv("DISCORD_TEST_CHANNEL_ID")
+	if testChannelID == "" {
+		t.Skip("DISCORD_TEST_CHANNEL_ID envvar must be set for acceptance tests")
+	}
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+	resource "discord_thread" "example" {
+	  channel_id = "%[1]s"
+	  name = "terraform-thread-news"
+	  type = "news"
+	}`, testChannelID),
+				ExpectError: regexp.MustCompile("news threads can only be created in news channels"),
+			},
+		},
+	})
+}
+
+func testAccResourceDiscordThread(channelID string, name string) string {
+	return fmt.Sprintf(`
+	resource "discord_thread" "example" {
+	  channel_id = "%[1]s"
+      name = "%[2]s"
+      type = "private"
+      auto_archive_duration = 1440
+      invitable = false
+      rate_limit_per_user = 10
+	}`, channelID, name)
+}
+
+func testAccResourceDiscordThreadForum(serverID string) string {
+	return fmt.Sprintf(`
+	resource "discord_forum_channel" "example" {
+	  server_id = "%[1]s"
+      name = "terraform-thread-forum"
+      sync_perms_with_category = false
+
+      available_tags {
+        name = "faq"
+      }
+	}
+
+	resource "discord_thread" "example" {
+	  channel_id = discord_forum_channel.example.id
+      name = "FAQ"
+      locked = true
+      applied_tags = [discord_forum_channel.example.available_tags[0].id]
+
+      message {
+        content = "Read this first"
+
+        embed = {
+          title = "FAQ"
+        }
+      }
+	}`, serverID)
+}
@@ -0,0 +1,175 @@
+package discord
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/net/context"
+)
+
+// vanityURLBase is where vanity invite codes are served.
+const vanityURLBase = "https://discord.gg/"
+
+type vanityURLResource struct {
+	frameworkResource
+}
+
+type vanityURLModel struct {
+	ServerId types.String `tfsdk:"server_id"`
+	Code     types.String `tfsdk:"code"`
+	URL      types.String `tfsdk:"url"`
+	Id       types.String `tfsdk:"id"`
+}
+
+func resourceDiscordVanityURL() resource.Resource {
+	return &vanityURLResource{}
+}
+
+func (r *vanityURLResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vanity_url"
+}
+
+func (r *vanityURLResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// Version 1 moved the resource from the SDK to
+		// terraform-plugin-framework.
+		Version:     1,
+		Description: "A resource to manage the vanity invite code of a server. Only servers with the `VANITY_URL` feature have one.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "ID of the server the vanity URL is for.",
+			},
+			"code": schema.StringAttribute{
+				Required:    true,
+				Description: "The vanity invite code.",
+			},
+			"url": schema.StringAttribute{
+				Computed:    true,
+				Description: "The vanity invite URL.",
+			},
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The ID of the server.",
+			},
+		},
+	}
+}
+
+func (r *vanityURLResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
+
+	return map[int64]resource.StateUpgrader{0: upgradeFromSDK(resp.Schema)}
+}
+
+func (r *vanityURLResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), req.ID)...)
+}
+
+// read refreshes m with the vanity URL of its server, and reports whether
+// the server still exists.
+func (r *vanityURLResource) read(ctx context.Context, m *vanityURLModel) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	serverId := m.ServerId.ValueString()
+	server, err := r.client.Guild(serverId, discordgo.WithContext(ctx))
+	if err != nil {
+		if isNotFound(err) {
+			return false, diags
+		}
+
+		diags.AddError(fmt.Sprintf("Failed to fetch server %s", serverId), err.Error())
+		return true, diags
+	}
+
+	m.Id = types.StringValue(serverId)
+	m.Code = types.StringValue(server.VanityURLCode)
+	m.URL = types.StringValue("")
+	if server.VanityURLCode != "" {
+		m.URL = types.StringValue(vanityURLBase + server.VanityURLCode)
+	}
+
+	return true, diags
+}
+
+func (r *vanityURLResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan vanityURLModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.update(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *vanityURLResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state vanityURLModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, diags := r.read(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *vanityURLResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan vanityURLModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.update(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// update sets the vanity URL in plan, and refreshes plan from it.
+func (r *vanityURLResource) update(ctx context.Context, plan *vanityURLModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	serverId := plan.ServerId.ValueString()
+	endpoint := discordgo.EndpointGuild(serverId) + "/vanity-url"
+	if _, err := r.client.RequestWithBucketID(http.MethodPatch, endpoint, map[string]interface{}{
+		"code": plan.Code.ValueString(),
+	}, endpoint, discordgo.WithContext(ctx)); err != nil {
+		diags.AddError(fmt.Sprintf("Failed to update vanity URL of server %s", serverId), err.Error())
+		return diags
+	}
+
+	_, readDiags := r.read(ctx, plan)
+	diags.Append(readDiags...)
+
+	return diags
+}
+
+func (r *vanityURLResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.AddWarning("Deleting the vanity URL is not allowed, it is left as is", "")
+}
@@ -1,31 +1,47 @@
 package discord
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
-func resourceDiscordVoiceChannel() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: resourceChannelCreate,
-		ReadContext:   resourceChannelRead,
-		UpdateContext: resourceChannelUpdate,
-		DeleteContext: resourceChannelDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-		Description: "A resource to create a voice channel.",
-		Schema: getChannelSchema("voice", map[string]*schema.Schema{
-			"bitrate": {
-				Type:        schema.TypeInt,
+func resourceDiscordVoiceChannel() resource.Resource {
+	return &channelResource{
+		channelType: "voice",
+		description: "A resource to create a voice channel.",
+		attributes: map[string]schema.Attribute{
+			"bitrate": schema.Int64Attribute{
 				Optional:    true,
-				Default:     64000,
+				Computed:    true,
+				Default:     int64default.StaticInt64(64000),
 				Description: "Bitrate of the channel.",
 			},
-			"user_limit": {
-				Type:        schema.TypeInt,
+			"user_limit": schema.Int64Attribute{
 				Optional:    true,
 				Description: "User limit of the channel.",
 			},
-		}),
+			"nsfw": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the channel is NSFW.",
+			},
+			"rtc_region": schema.StringAttribute{
+				Optional:    true,
+				Description: "Voice region of the channel. Discord picks one automatically when not set.",
+			},
+			"video_quality_mode": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("auto"),
+				Validators:  []validator.String{stringvalidator.OneOf("auto", "full")},
+				Description: "Video quality of the channel, either `auto` or `full`.",
+			},
+		},
 	}
 }
@@ -15,8 +15,8 @@ func TestAccResourceDiscordVoiceChannel(t *testing.T) {
 	}
 	name := "discord_voice_channel.example"
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccResourceDiscordVoiceChannel(testServerID),
@@ -27,6 +27,8 @@ func TestAccResourceDiscordVoiceChannel(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "position", "1"),
 					resource.TestCheckResourceAttr(name, "bitrate", "64000"),
 					resource.TestCheckResourceAttr(name, "user_limit", "4"),
+					resource.TestCheckResourceAttr(name, "nsfw", "true"),
+					resource.TestCheckResourceAttr(name, "video_quality_mode", "full"),
 					resource.TestCheckResourceAttr(name, "sync_perms_with_category", "false"),
 					resource.TestCheckResourceAttrSet(name, "channel_id"),
 				),
@@ -43,6 +45,8 @@ func testAccResourceDiscordVoiceChannel(serverID string) string {
       position = 1
       bitrate = 64000
       user_limit = 4
+      nsfw = true
+      video_quality_mode = "full"
       sync_perms_with_category = false
 	}`, serverID)
 }
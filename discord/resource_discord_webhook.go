@@ -1,174 +1,214 @@
 package discord
 
 import (
+	"fmt"
+
 	"github.com/bwmarrin/discordgo"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/polds/imgbase64"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"golang.org/x/net/context"
 )
 
-func resourceDiscordWebhook() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: resourceWebhookCreate,
-		ReadContext:   resourceWebhookRead,
-		UpdateContext: resourceWebhookUpdate,
-		DeleteContext: resourceWebhookDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+type webhookResource struct {
+	frameworkResource
+}
+
+type webhookModel struct {
+	ChannelId     types.String `tfsdk:"channel_id"`
+	Name          types.String `tfsdk:"name"`
+	AvatarURL     types.String `tfsdk:"avatar_url"`
+	AvatarDataURI types.String `tfsdk:"avatar_data_uri"`
+	AvatarHash    types.String `tfsdk:"avatar_hash"`
+	Token         types.String `tfsdk:"token"`
+	URL           types.String `tfsdk:"url"`
+	SlackURL      types.String `tfsdk:"slack_url"`
+	GithubURL     types.String `tfsdk:"github_url"`
+	Id            types.String `tfsdk:"id"`
+}
+
+func resourceDiscordWebhook() resource.Resource {
+	return &webhookResource{}
+}
 
+func (r *webhookResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook"
+}
+
+func (r *webhookResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// Version 1 moved the resource from the SDK to
+		// terraform-plugin-framework.
+		Version:     1,
 		Description: "A resource to create a webhook for a channel.",
-		Schema: map[string]*schema.Schema{
-			"channel_id": {
-				Type:        schema.TypeString,
+		Attributes: map[string]schema.Attribute{
+			"channel_id": schema.StringAttribute{
 				Required:    true,
 				Description: "ID of the channel to create a webhook for.",
 			},
-			"name": {
-				Type:        schema.TypeString,
+			"name": schema.StringAttribute{
 				Required:    true,
 				Description: "Default name of the webhook.",
 			},
-			"avatar_url": {
-				Type:          schema.TypeString,
-				Optional:      true,
-				ConflictsWith: []string{"avatar_data_uri"},
-				Description:   "Remote URL for setting the default avatar of the webhook.",
+			"avatar_url": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.ConflictsWith(path.MatchRoot("avatar_data_uri"))},
+				Description: "Remote URL for setting the default avatar of the webhook.",
 			},
-			"avatar_data_uri": {
-				Type:          schema.TypeString,
-				Optional:      true,
-				ConflictsWith: []string{"avatar_url"},
-				Description:   "Data URI of an image to set as the default avatar of the webhook.",
+			"avatar_data_uri": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.ConflictsWith(path.MatchRoot("avatar_url"))},
+				Description: "Data URI of an image to set as the default avatar of the webhook.",
 			},
-			"avatar_hash": {
-				Type:        schema.TypeString,
+			"avatar_hash": schema.StringAttribute{
 				Computed:    true,
 				Description: "Hash of the avatar.",
 			},
-			"token": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Sensitive:   true,
-				Description: "The webhook token.",
+			"token": schema.StringAttribute{
+				Computed:      true,
+				Sensitive:     true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The webhook token.",
 			},
-			"url": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Sensitive:   true,
-				Description: "The webhook URL.",
+			"url": schema.StringAttribute{
+				Computed:      true,
+				Sensitive:     true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The webhook URL.",
 			},
-			"slack_url": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Sensitive:   true,
-				Description: "The Slack-compatible webhook URL.",
+			"slack_url": schema.StringAttribute{
+				Computed:      true,
+				Sensitive:     true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The Slack-compatible webhook URL.",
 			},
-			"github_url": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Sensitive:   true,
-				Description: "The GitHub-compatible webhook URL.",
+			"github_url": schema.StringAttribute{
+				Computed:      true,
+				Sensitive:     true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The GitHub-compatible webhook URL.",
 			},
-			"id": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "The ID of the webhook.",
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The ID of the webhook.",
 			},
 		},
 	}
 }
 
-func resourceWebhookCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	client := m.(*Context).Session
+func (r *webhookResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
 
-	channelId := d.Get("channel_id").(string)
+	return map[int64]resource.StateUpgrader{0: upgradeFromSDK(resp.Schema)}
+}
 
-	avatar := ""
-	if v, ok := d.GetOk("avatar_url"); ok {
-		avatar = imgbase64.FromRemote(v.(string))
+// ModifyPlan keeps the hash of the avatar while the avatar isn't changed.
+func (r *webhookResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
 	}
-	if v, ok := d.GetOk("avatar_data_uri"); ok {
-		avatar = v.(string)
+
+	var plan, state webhookModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if webhook, err := client.WebhookCreate(channelId, d.Get("name").(string), avatar, discordgo.WithContext(ctx)); err != nil {
-		return diag.Errorf("Failed to create webhook: %s", err.Error())
-	} else {
-		url := "https://discord.com/api/webhooks/" + webhook.ID + "/" + webhook.Token
+	if plan.AvatarURL.Equal(state.AvatarURL) && plan.AvatarDataURI.Equal(state.AvatarDataURI) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("avatar_hash"), state.AvatarHash)...)
+	}
+}
 
-		d.SetId(webhook.ID)
-		d.Set("avatar_hash", webhook.Avatar)
-		d.Set("token", webhook.Token)
-		d.Set("url", url)
-		d.Set("slack_url", url+"/slack")
-		d.Set("github_url", url+"/github")
+func (r *webhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
 
-		return diags
-	}
+// setWebhook sets the attributes of m that Discord returns for webhook.
+func setWebhook(m *webhookModel, webhook *discordgo.Webhook) {
+	url := "https://discord.com/api/webhooks/" + webhook.ID + "/" + webhook.Token
+
+	m.Id = types.StringValue(webhook.ID)
+	m.ChannelId = types.StringValue(webhook.ChannelID)
+	m.Name = types.StringValue(webhook.Name)
+	m.AvatarHash = types.StringValue(webhook.Avatar)
+	m.Token = types.StringValue(webhook.Token)
+	m.URL = types.StringValue(url)
+	m.SlackURL = types.StringValue(url + "/slack")
+	m.GithubURL = types.StringValue(url + "/github")
 }
 
-func resourceWebhookRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	client := m.(*Context).Session
-
-	if webhook, err := client.Webhook(d.Id(), discordgo.WithContext(ctx)); err != nil {
-		d.SetId("")
-	} else {
-		url := "https://discord.com/api/webhooks/" + webhook.ID + "/" + webhook.Token
-
-		d.Set("channel_id", webhook.ChannelID)
-		d.Set("name", webhook.Name)
-		d.Set("avatar_hash", webhook.Avatar)
-		d.Set("token", webhook.Token)
-		d.Set("url", url)
-		d.Set("slack_url", url+"/slack")
-		d.Set("github_url", url+"/github")
+func (r *webhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan webhookModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	return diags
+	webhook, err := r.client.WebhookCreate(plan.ChannelId.ValueString(), plan.Name.ValueString(), buildImage(plan.AvatarURL, plan.AvatarDataURI), discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create webhook", err.Error())
+		return
+	}
+
+	setWebhook(&plan, webhook)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func resourceWebhookUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	client := m.(*Context).Session
+func (r *webhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state webhookModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	channelId := d.Get("channel_id").(string)
-	name := d.Get("name").(string)
+	webhook, err := r.client.Webhook(state.Id.ValueString(), discordgo.WithContext(ctx))
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 
-	avatar := ""
-	if v, ok := d.GetOk("avatar_url"); ok {
-		avatar = imgbase64.FromRemote(v.(string))
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to fetch webhook %s", state.Id.ValueString()), err.Error())
+		return
 	}
-	if v, ok := d.GetOk("avatar_data_uri"); ok {
-		avatar = v.(string)
+
+	setWebhook(&state, webhook)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *webhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan webhookModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if webhook, err := client.WebhookEdit(d.Id(), name, avatar, channelId, discordgo.WithContext(ctx)); err != nil {
-		return diag.Errorf("Failed to update webhook %s: %s", d.Id(), err.Error())
-	} else {
-		url := "https://discord.com/api/webhooks/" + webhook.ID + "/" + webhook.Token
-		d.Set("channel_id", webhook.ChannelID)
-		d.Set("name", webhook.Name)
-		d.Set("avatar_hash", webhook.Avatar)
-		d.Set("token", webhook.Token)
-		d.Set("url", url)
-		d.Set("slack_url", url+"/slack")
-		d.Set("github_url", url+"/github")
+	webhook, err := r.client.WebhookEdit(plan.Id.ValueString(), plan.Name.ValueString(), buildImage(plan.AvatarURL, plan.AvatarDataURI), plan.ChannelId.ValueString(), discordgo.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to update webhook %s", plan.Id.ValueString()), err.Error())
+		return
 	}
 
-	return diags
+	setWebhook(&plan, webhook)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func resourceWebhookDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	client := m.(*Context).Session
+func (r *webhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state webhookModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	if err := client.WebhookDelete(d.Id(), discordgo.WithContext(ctx)); err != nil {
-		return diag.FromErr(err)
-	} else {
-		return diags
+	if err := r.client.WebhookDelete(state.Id.ValueString(), discordgo.WithContext(ctx)); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to delete webhook %s", state.Id.ValueString()), err.Error())
 	}
 }
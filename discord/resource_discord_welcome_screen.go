@@ -0,0 +1,299 @@
+package discord
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/net/context"
+)
+
+// welcomeScreen is the welcome screen of a server, which discordgo has no
+// endpoints for. Whether it is enabled is only exposed as a server feature.
+type welcomeScreen struct {
+	Description     string                 `json:"description"`
+	WelcomeChannels []welcomeScreenChannel `json:"welcome_channels"`
+}
+
+type welcomeScreenChannel struct {
+	ChannelID   string `json:"channel_id"`
+	Description string `json:"description"`
+	EmojiID     string `json:"emoji_id"`
+	EmojiName   string `json:"emoji_name"`
+}
+
+type welcomeScreenResource struct {
+	frameworkResource
+}
+
+type welcomeScreenModel struct {
+	ServerId        types.String                `tfsdk:"server_id"`
+	Enabled         types.Bool                  `tfsdk:"enabled"`
+	Description     types.String                `tfsdk:"description"`
+	WelcomeChannels []welcomeScreenChannelModel `tfsdk:"welcome_channel"`
+	Id              types.String                `tfsdk:"id"`
+}
+
+type welcomeScreenChannelModel struct {
+	ChannelId   types.String `tfsdk:"channel_id"`
+	Description types.String `tfsdk:"description"`
+	Emoji       types.String `tfsdk:"emoji"`
+}
+
+func resourceDiscordWelcomeScreen() resource.Resource {
+	return &welcomeScreenResource{}
+}
+
+func (r *welcomeScreenResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_welcome_screen"
+}
+
+func (r *welcomeScreenResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// Version 1 moved the resource from the SDK to
+		// terraform-plugin-framework.
+		Version:     1,
+		Description: "A resource to manage the welcome screen of a Community server. Deleting it disables and clears the welcome screen.",
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "ID of the server the welcome screen is in.",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the welcome screen is shown to new members.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.LengthBetween(0, 140)},
+				Description: "Description of the server shown on the welcome screen.",
+			},
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The ID of the server.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"welcome_channel": schema.ListNestedBlock{
+				Validators:  []validator.List{listvalidator.SizeAtMost(5)},
+				Description: "Channels shown on the welcome screen, in order.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"channel_id": schema.StringAttribute{
+							Required:    true,
+							Description: "ID of the channel.",
+						},
+						"description": schema.StringAttribute{
+							Required:    true,
+							Validators:  []validator.String{stringvalidator.LengthBetween(1, 50)},
+							Description: "Description shown for the channel.",
+						},
+						"emoji": schema.StringAttribute{
+							Optional:    true,
+							Description: "Emoji shown for the channel, either a Unicode emoji or the ID of a custom emoji of the server.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *welcomeScreenResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
+
+	return map[int64]resource.StateUpgrader{0: upgradeFromSDK(resp.Schema)}
+}
+
+func (r *welcomeScreenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), req.ID)...)
+}
+
+// unbuildWelcomeScreenEmoji returns the emoji of a welcome channel the way
+// it's configured. Custom emojis come back with their name too, so their ID
+// takes precedence.
+func unbuildWelcomeScreenEmoji(c welcomeScreenChannel) string {
+	if c.EmojiID != "" {
+		return c.EmojiID
+	}
+
+	return c.EmojiName
+}
+
+func (r *welcomeScreenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan welcomeScreenModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.update(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// read refreshes m with the welcome screen of its server, and reports whether
+// the server still exists.
+func (r *welcomeScreenResource) read(ctx context.Context, m *welcomeScreenModel) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	serverId := m.ServerId.ValueString()
+	settings, err := getServerSettings(r.client, ctx, serverId)
+	if err != nil {
+		if isNotFound(err) {
+			return false, diags
+		}
+
+		diags.AddError(fmt.Sprintf("Failed to fetch server %s", serverId), err.Error())
+		return true, diags
+	}
+
+	endpoint := discordgo.EndpointGuild(serverId) + "/welcome-screen"
+	body, err := r.client.RequestWithBucketID(http.MethodGet, endpoint, nil, endpoint, discordgo.WithContext(ctx))
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Failed to fetch welcome screen of server %s", serverId), err.Error())
+		return true, diags
+	}
+	var screen welcomeScreen
+	if err := json.Unmarshal(body, &screen); err != nil {
+		diags.AddError(fmt.Sprintf("Failed to fetch welcome screen of server %s", serverId), err.Error())
+		return true, diags
+	}
+
+	channels := make([]welcomeScreenChannelModel, 0, len(screen.WelcomeChannels))
+	for i, c := range screen.WelcomeChannels {
+		prior := types.StringNull()
+		if i < len(m.WelcomeChannels) {
+			prior = m.WelcomeChannels[i].Emoji
+		}
+		channels = append(channels, welcomeScreenChannelModel{
+			ChannelId:   types.StringValue(c.ChannelID),
+			Description: types.StringValue(c.Description),
+			Emoji:       stringValue(unbuildWelcomeScreenEmoji(c), prior),
+		})
+	}
+
+	m.Id = types.StringValue(serverId)
+	m.Enabled = types.BoolValue(contains(settings.Features, "WELCOME_SCREEN_ENABLED"))
+	m.Description = stringValue(screen.Description, m.Description)
+	m.WelcomeChannels = channels
+
+	return true, diags
+}
+
+func (r *welcomeScreenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state welcomeScreenModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, diags := r.read(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *welcomeScreenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan welcomeScreenModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.update(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// update replaces the welcome screen with the one in plan, and refreshes plan
+// from it.
+func (r *welcomeScreenResource) update(ctx context.Context, plan *welcomeScreenModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	serverId := plan.ServerId.ValueString()
+
+	channels := make([]map[string]interface{}, 0)
+	for _, c := range plan.WelcomeChannels {
+		channel := map[string]interface{}{
+			"channel_id":  c.ChannelId.ValueString(),
+			"description": c.Description.ValueString(),
+			"emoji_id":    nil,
+			"emoji_name":  nil,
+		}
+		if emoji := c.Emoji.ValueString(); emoji != "" {
+			if _, err := strconv.ParseUint(emoji, 10, 64); err == nil {
+				channel["emoji_id"] = emoji
+			} else {
+				channel["emoji_name"] = emoji
+			}
+		}
+		channels = append(channels, channel)
+	}
+
+	var description interface{}
+	if v := plan.Description.ValueString(); v != "" {
+		description = v
+	}
+
+	endpoint := discordgo.EndpointGuild(serverId) + "/welcome-screen"
+	if _, err := r.client.RequestWithBucketID(http.MethodPatch, endpoint, map[string]interface{}{
+		"enabled":          plan.Enabled.ValueBool(),
+		"description":      description,
+		"welcome_channels": channels,
+	}, endpoint, discordgo.WithContext(ctx)); err != nil {
+		diags.AddError(fmt.Sprintf("Failed to update welcome screen of server %s", serverId), err.Error())
+		return diags
+	}
+
+	_, readDiags := r.read(ctx, plan)
+	diags.Append(readDiags...)
+
+	return diags
+}
+
+func (r *welcomeScreenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state welcomeScreenModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverId := state.ServerId.ValueString()
+	endpoint := discordgo.EndpointGuild(serverId) + "/welcome-screen"
+	if _, err := r.client.RequestWithBucketID(http.MethodPatch, endpoint, map[string]interface{}{
+		"enabled":          false,
+		"description":      nil,
+		"welcome_channels": []interface{}{},
+	}, endpoint, discordgo.WithContext(ctx)); err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to delete welcome screen of server %s", serverId), err.Error())
+	}
+}
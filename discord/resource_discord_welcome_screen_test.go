@@ -0,0 +1,57 @@
+package discord
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceDiscordWelcomeScreen(t *testing.T) {
+	testServerID := os.Getenv("DISCORD_TEST_SERVER_ID")
+	testChannelID := os.Getenv("DISCORD_TEST_CHANNEL_ID")
+	if testServerID == "" || testChannelID == "" {
+		t.Skip("DISCORD_TEST_SERVER_ID and DISCORD_TEST_CHANNEL_ID envvars must be set for acceptance tests")
+	}
+	name := "discord_welcome_screen.example"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDiscordWelcomeScreen(testServerID, testChannelID, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "server_id", testServerID),
+					resource.TestCheckResourceAttr(name, "enabled", "true"),
+					resource.TestCheckResourceAttr(name, "description", "terraform-welcome-screen"),
+					resource.TestCheckResourceAttr(name, "welcome_channel.#", "1"),
+					resource.TestCheckResourceAttr(name, "welcome_channel.0.channel_id", testChannelID),
+					resource.TestCheckResourceAttr(name, "welcome_channel.0.emoji", "👋"),
+				),
+			},
+			{
+				Config: testAccResourceDiscordWelcomeScreen(testServerID, testChannelID, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceDiscordWelcomeScreen(serverID string, channelID string, enabled bool) string {
+	return fmt.Sprintf(`
+	resource "discord_welcome_screen" "example" {
+	  server_id = "%[1]s"
+	  enabled = %[3]t
+	  description = "terraform-welcome-screen"
+
+	  welcome_channel {
+	    channel_id = "%[2]s"
+	    description = "Say hi"
+	    emoji = "👋"
+	  }
+	}`, serverID, channelID, enabled)
+}
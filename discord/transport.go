@@ -0,0 +1,213 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// rateLimitStrategyWait waits out 429 responses and retries the request.
+	rateLimitStrategyWait = "wait"
+	// rateLimitStrategyFail returns 429 responses as errors straight away.
+	rateLimitStrategyFail = "fail"
+)
+
+// globalRateLimitBucket is a discordgo bucket that no request uses. It is only
+// locked to pass global rate limits on to discordgo.
+const globalRateLimitBucket = "terraform-provider-discord/global"
+
+// retryTransport retries requests that Discord rate limited or failed to
+// handle. Rate limited requests are retried after the delay Discord asks for,
+// while server errors and network failures are retried with exponential
+// backoff, but only for idempotent methods so nothing is created twice.
+//
+// discordgo already waits for exhausted buckets before sending a request, so
+// this only deals with the responses that slip through.
+type retryTransport struct {
+	next http.RoundTripper
+	// limiter is the rate limiter of the discordgo session. discordgo never
+	// sees the 429s waited out here, so global rate limits are passed on to it
+	// to hold back the requests of other goroutines as well.
+	limiter           *discordgo.RateLimiter
+	maxRetries        int
+	timeout           time.Duration
+	rateLimitStrategy string
+	// minBackoff is the delay before the first retry of a failed request,
+	// doubled for each retry after it.
+	minBackoff time.Duration
+	maxBackoff time.Duration
+}
+
+func newRetryTransport(maxRetries int, timeout time.Duration, rateLimitStrategy string) *retryTransport {
+	return &retryTransport{
+		maxRetries:        maxRetries,
+		timeout:           timeout,
+		rateLimitStrategy: rateLimitStrategy,
+		minBackoff:        time.Second,
+		maxBackoff:        30 * time.Second,
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	for attempt := 0; ; attempt++ {
+		res, err := t.roundTrip(req)
+		if attempt >= t.maxRetries {
+			return res, err
+		}
+
+		var wait time.Duration
+		switch {
+		case err != nil:
+			if !isIdempotent(req.Method) || ctx.Err() != nil {
+				return res, err
+			}
+			wait = t.backoff(attempt)
+			tflog.Warn(ctx, "Request to Discord failed, retrying", map[string]interface{}{
+				"method":  req.Method,
+				"url":     req.URL.String(),
+				"error":   err.Error(),
+				"attempt": attempt + 1,
+				"wait":    wait.String(),
+			})
+		case res.StatusCode == http.StatusTooManyRequests:
+			if t.rateLimitStrategy == rateLimitStrategyFail {
+				return res, err
+			}
+			wait = getRetryAfter(res.Header)
+			global := res.Header.Get("X-RateLimit-Global") == "true"
+			if global {
+				t.holdGlobalRateLimit(wait)
+			}
+			tflog.Warn(ctx, "Rate limited by Discord, waiting before retrying", map[string]interface{}{
+				"method":  req.Method,
+				"url":     req.URL.String(),
+				"global":  global,
+				"bucket":  res.Header.Get("X-RateLimit-Bucket"),
+				"attempt": attempt + 1,
+				"wait":    wait.String(),
+			})
+		case res.StatusCode >= http.StatusInternalServerError && isIdempotent(req.Method):
+			wait = t.backoff(attempt)
+			tflog.Warn(ctx, "Discord returned a server error, retrying", map[string]interface{}{
+				"method":  req.Method,
+				"url":     req.URL.String(),
+				"status":  res.StatusCode,
+				"attempt": attempt + 1,
+				"wait":    wait.String(),
+			})
+		default:
+			return res, err
+		}
+
+		if res != nil {
+			io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+		}
+		if req.Body != nil {
+			if req.GetBody == nil {
+				return nil, errors.New("can't retry request with a body that can't be rewound")
+			}
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(ctx)
+			req.Body = body
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// roundTrip sends a single attempt of req, limited to the request timeout.
+func (t *retryTransport) roundTrip(req *http.Request) (*http.Response, error) {
+	next := t.next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	if t.timeout <= 0 {
+		return next.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+	res, err := next.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	// The timeout has to outlive RoundTrip so the body can still be read.
+	res.Body = &cancelOnClose{ReadCloser: res.Body, cancel: cancel}
+
+	return res, nil
+}
+
+// holdGlobalRateLimit makes discordgo hold back every request for wait. The
+// bucket of the rate limited request stays locked by discordgo until the
+// request returns, so only global rate limits have to be passed on.
+func (t *retryTransport) holdGlobalRateLimit(wait time.Duration) {
+	if t.limiter == nil {
+		return
+	}
+
+	header := http.Header{}
+	header.Set("X-RateLimit-Global", "true")
+	header.Set("X-RateLimit-Reset-After", strconv.FormatFloat(wait.Seconds(), 'f', 3, 64))
+	t.limiter.LockBucket(globalRateLimitBucket).Release(header)
+}
+
+func (t *retryTransport) backoff(attempt int) time.Duration {
+	wait := time.Duration(float64(t.minBackoff) * math.Pow(2, float64(attempt)))
+	if wait > t.maxBackoff {
+		return t.maxBackoff
+	}
+
+	return wait
+}
+
+// getRetryAfter returns how long Discord asked to wait before retrying a rate
+// limited request. Retry-After is in whole seconds, X-RateLimit-Reset-After is
+// more precise and preferred when it's there.
+func getRetryAfter(header http.Header) time.Duration {
+	for _, key := range []string{"X-RateLimit-Reset-After", "Retry-After"} {
+		if seconds, err := strconv.ParseFloat(header.Get(key), 64); err == nil && seconds >= 0 {
+			return time.Duration(seconds * float64(time.Second))
+		}
+	}
+
+	return time.Second
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	return false
+}
+
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	defer c.cancel()
+
+	return c.ReadCloser.Close()
+}
@@ -0,0 +1,149 @@
+package discord
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestRetryTransport(t *testing.T) {
+	params := []struct {
+		name       string
+		method     string
+		strategy   string
+		maxRetries int
+		statuses   []int
+		wantStatus int
+		wantCalls  int
+	}{
+		{name: "success", method: "GET", strategy: "wait", maxRetries: 3, statuses: []int{200}, wantStatus: 200, wantCalls: 1},
+		{name: "rate limited", method: "GET", strategy: "wait", maxRetries: 3, statuses: []int{429, 429, 200}, wantStatus: 200, wantCalls: 3},
+		{name: "rate limited post", method: "POST", strategy: "wait", maxRetries: 3, statuses: []int{429, 201}, wantStatus: 201, wantCalls: 2},
+		{name: "rate limited fail", method: "GET", strategy: "fail", maxRetries: 3, statuses: []int{429, 200}, wantStatus: 429, wantCalls: 1},
+		{name: "server error", method: "GET", strategy: "wait", maxRetries: 3, statuses: []int{503, 502, 200}, wantStatus: 200, wantCalls: 3},
+		{name: "server error put", method: "PUT", strategy: "wait", maxRetries: 3, statuses: []int{500, 204}, wantStatus: 204, wantCalls: 2},
+		{name: "server error post", method: "POST", strategy: "wait", maxRetries: 3, statuses: []int{503, 201}, wantStatus: 503, wantCalls: 1},
+		{name: "client error", method: "GET", strategy: "wait", maxRetries: 3, statuses: []int{404, 200}, wantStatus: 404, wantCalls: 1},
+		{name: "retries exhausted", method: "DELETE", strategy: "wait", maxRetries: 2, statuses: []int{500, 500, 500, 204}, wantStatus: 500, wantCalls: 3},
+	}
+
+	for _, p := range params {
+		t.Run(p.name, func(t *testing.T) {
+			calls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if body, _ := io.ReadAll(r.Body); r.Method != "GET" && string(body) != `{"name":"test"}` {
+					t.Errorf("call %d got body %q", calls, body)
+				}
+				status := p.statuses[calls]
+				calls++
+				if status == http.StatusTooManyRequests {
+					w.Header().Set("Retry-After", "1")
+					w.Header().Set("X-RateLimit-Reset-After", "0.001")
+				}
+				w.WriteHeader(status)
+			}))
+			defer server.Close()
+
+			transport := newRetryTransport(p.maxRetries, time.Second, p.strategy)
+			transport.next = server.Client().Transport
+			transport.minBackoff = time.Millisecond
+
+			var body io.Reader
+			if p.method != "GET" {
+				body = strings.NewReader(`{"name":"test"}`)
+			}
+			req, _ := http.NewRequest(p.method, server.URL, body)
+			res, err := (&http.Client{Transport: transport}).Do(req)
+			if err != nil {
+				t.Fatalf("request failed: %s", err)
+			}
+			res.Body.Close()
+
+			if res.StatusCode != p.wantStatus {
+				t.Errorf("status = %d, want %d", res.StatusCode, p.wantStatus)
+			}
+			if calls != p.wantCalls {
+				t.Errorf("calls = %d, want %d", calls, p.wantCalls)
+			}
+		})
+	}
+}
+
+func TestRetryTransportGlobalRateLimit(t *testing.T) {
+	limited := make(chan struct{})
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("X-RateLimit-Global", "true")
+			w.Header().Set("X-RateLimit-Reset-After", "0.5")
+			w.WriteHeader(http.StatusTooManyRequests)
+			close(limited)
+		}
+	}))
+	defer server.Close()
+
+	limiter := discordgo.NewRatelimiter()
+	transport := newRetryTransport(1, time.Second, rateLimitStrategyWait)
+	transport.next = server.Client().Transport
+	transport.limiter = limiter
+
+	// Another goroutine's request has to wait for the global rate limit too.
+	held := make(chan time.Duration)
+	go func() {
+		<-limited
+		time.Sleep(50 * time.Millisecond)
+		held <- limiter.GetWaitTime(limiter.GetBucket("other"), 1)
+	}()
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	res, err := (&http.Client{Transport: transport}).Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %s", err)
+	}
+	res.Body.Close()
+
+	if wait := <-held; wait <= 0 {
+		t.Errorf("other bucket wait = %s, want it held back", wait)
+	}
+}
+
+func TestRetryTransportTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	}))
+	defer server.Close()
+
+	transport := newRetryTransport(0, 10*time.Millisecond, "wait")
+	transport.next = server.Client().Transport
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	if _, err := (&http.Client{Transport: transport}).Do(req); err == nil {
+		t.Error("expected request to time out")
+	}
+}
+
+func TestGetRetryAfter(t *testing.T) {
+	params := []struct {
+		header http.Header
+		want   time.Duration
+	}{
+		{header: http.Header{"Retry-After": {"3"}}, want: 3 * time.Second},
+		{header: http.Header{"Retry-After": {"3"}, "X-Ratelimit-Reset-After": {"2.5"}}, want: 2500 * time.Millisecond},
+		{header: http.Header{"Retry-After": {"soon"}}, want: time.Second},
+		{header: http.Header{}, want: time.Second},
+	}
+
+	for _, p := range params {
+		if got := getRetryAfter(p.header); got != p.want {
+			t.Errorf("getRetryAfter(%v) = %s, want %s", p.header, got, p.want)
+		}
+	}
+}
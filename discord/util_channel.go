@@ -2,8 +2,13 @@ package discord
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"sort"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 func getTextChannelType(channelType discordgo.ChannelType) (string, bool) {
@@ -18,6 +23,12 @@ func getTextChannelType(channelType discordgo.ChannelType) (string, bool) {
 		return "news", true
 	case 6:
 		return "store", true
+	case 13:
+		return "stage", true
+	case 15:
+		return "forum", true
+	case 16:
+		return "media", true
 	}
 
 	return "text", false
@@ -35,6 +46,12 @@ func getDiscordChannelType(name string) (discordgo.ChannelType, bool) {
 		return discordgo.ChannelTypeGuildNews, true
 	case "store":
 		return discordgo.ChannelTypeGuildStore, true
+	case "stage":
+		return discordgo.ChannelTypeGuildStageVoice, true
+	case "forum":
+		return discordgo.ChannelTypeGuildForum, true
+	case "media":
+		return discordgo.ChannelTypeGuildMedia, true
 	}
 
 	return 0, false
@@ -56,6 +73,21 @@ func findChannelById(array []*discordgo.Channel, id string) *discordgo.Channel {
 	return nil
 }
 
+// sortChannels sorts channels by their position, the way Discord shows them.
+// Channels with the same position are ordered by their ID.
+func sortChannels(channels []*discordgo.Channel) {
+	sort.SliceStable(channels, func(i, j int) bool {
+		if channels[i].Position != channels[j].Position {
+			return channels[i].Position < channels[j].Position
+		}
+		if len(channels[i].ID) != len(channels[j].ID) {
+			return len(channels[i].ID) < len(channels[j].ID)
+		}
+
+		return channels[i].ID < channels[j].ID
+	})
+}
+
 func arePermissionsSynced(from *discordgo.Channel, to *discordgo.Channel) bool {
 	for _, p1 := range from.PermissionOverwrites {
 		cont := false
@@ -88,7 +120,7 @@ func arePermissionsSynced(from *discordgo.Channel, to *discordgo.Channel) bool {
 
 func syncChannelPermissions(c *discordgo.Session, ctx context.Context, from *discordgo.Channel, to *discordgo.Channel) error {
 	for _, p := range to.PermissionOverwrites {
-		if err := c.ChannelPermissionDelete(to.ID, p.ID); err != nil {
+		if err := c.ChannelPermissionDelete(to.ID, p.ID, discordgo.WithContext(ctx)); err != nil {
 			return err
 		}
 	}
@@ -112,3 +144,261 @@ func getDiscordChannelPermissionType(value string) (discordgo.PermissionOverwrit
 		return 0, false
 	}
 }
+
+func getTextChannelPermissionType(value discordgo.PermissionOverwriteType) string {
+	switch value {
+	case discordgo.PermissionOverwriteTypeMember:
+		return "user"
+	default:
+		return "role"
+	}
+}
+
+var forumSortOrders = map[string]discordgo.ForumSortOrderType{
+	"latest_activity": discordgo.ForumSortOrderLatestActivity,
+	"creation_date":   discordgo.ForumSortOrderCreationDate,
+}
+
+var forumLayouts = map[string]discordgo.ForumLayout{
+	"not_set":      discordgo.ForumLayoutNotSet,
+	"list_view":    discordgo.ForumLayoutListView,
+	"gallery_view": discordgo.ForumLayoutGalleryView,
+}
+
+func getTextForumSortOrder(order *discordgo.ForumSortOrderType) string {
+	if order != nil {
+		for k, v := range forumSortOrders {
+			if v == *order {
+				return k
+			}
+		}
+	}
+
+	return ""
+}
+
+func getTextForumLayout(layout discordgo.ForumLayout) string {
+	for k, v := range forumLayouts {
+		if v == layout {
+			return k
+		}
+	}
+
+	return "not_set"
+}
+
+// buildForumTags builds the tags of a forum channel from the plan. Discord
+// replaces the whole list on every update, so tags keep the ID of the tag at
+// the same position in prior, and a renamed tag stays on the posts it was
+// applied to.
+func buildForumTags(plan []forumTagModel, prior []forumTagModel) []discordgo.ForumTag {
+	tags := make([]discordgo.ForumTag, 0, len(plan))
+	for i, tag := range plan {
+		id := ""
+		if i < len(prior) {
+			id = prior[i].Id.ValueString()
+		}
+		tags = append(tags, discordgo.ForumTag{
+			ID:        id,
+			Name:      tag.Name.ValueString(),
+			Moderated: tag.Moderated.ValueBool(),
+			EmojiID:   tag.EmojiId.ValueString(),
+			EmojiName: tag.EmojiName.ValueString(),
+		})
+	}
+
+	return tags
+}
+
+// unbuildForumTags returns the tags of a forum channel. Emojis that aren't set
+// are null when they were null in the tag at the same position in prior.
+func unbuildForumTags(tags []discordgo.ForumTag, prior []forumTagModel) []forumTagModel {
+	res := make([]forumTagModel, 0, len(tags))
+	for i, tag := range tags {
+		p := forumTagModel{EmojiId: types.StringNull(), EmojiName: types.StringNull()}
+		if i < len(prior) {
+			p = prior[i]
+		}
+		res = append(res, forumTagModel{
+			Id:        types.StringValue(tag.ID),
+			Name:      types.StringValue(tag.Name),
+			Moderated: types.BoolValue(tag.Moderated),
+			EmojiId:   stringValue(tag.EmojiID, p.EmojiId),
+			EmojiName: stringValue(tag.EmojiName, p.EmojiName),
+		})
+	}
+
+	return res
+}
+
+// unbuildForumDefaultReaction is the same as unbuildForumTags, for the
+// default reaction.
+func unbuildForumDefaultReaction(reaction discordgo.ForumDefaultReaction, prior []forumReactionModel) []forumReactionModel {
+	if reaction.EmojiID == "" && reaction.EmojiName == "" {
+		return []forumReactionModel{}
+	}
+
+	p := forumReactionModel{EmojiId: types.StringNull(), EmojiName: types.StringNull()}
+	if len(prior) > 0 {
+		p = prior[0]
+	}
+
+	return []forumReactionModel{{
+		EmojiId:   stringValue(reaction.EmojiID, p.EmojiId),
+		EmojiName: stringValue(reaction.EmojiName, p.EmojiName),
+	}}
+}
+
+// updateForumChannel applies the forum only settings of plan that differ from
+// prior, which can't be passed when the channel is created.
+func updateForumChannel(c *discordgo.Session, ctx context.Context, channelId string, plan channelModel, prior channelModel) error {
+	edit := &discordgo.ChannelEdit{}
+	changed := false
+	// ChannelEdit omits empty fields, so settings being unset are nulled with a
+	// separate request.
+	nulls := map[string]interface{}{}
+
+	if !slices.Equal(plan.AvailableTags, prior.AvailableTags) {
+		tags := buildForumTags(plan.AvailableTags, prior.AvailableTags)
+		edit.AvailableTags = &tags
+		changed = true
+	}
+	if !plan.DefaultSortOrder.Equal(prior.DefaultSortOrder) {
+		if v, ok := forumSortOrders[plan.DefaultSortOrder.ValueString()]; ok {
+			edit.DefaultSortOrder = &v
+			changed = true
+		} else {
+			nulls["default_sort_order"] = nil
+		}
+	}
+	if !plan.DefaultForumLayout.Equal(prior.DefaultForumLayout) {
+		layout := forumLayouts[plan.DefaultForumLayout.ValueString()]
+		edit.DefaultForumLayout = &layout
+		changed = true
+	}
+	if !plan.DefaultThreadRateLimitPerUser.Equal(prior.DefaultThreadRateLimitPerUser) {
+		edit.DefaultThreadRateLimitPerUser = IntPtr(int(plan.DefaultThreadRateLimitPerUser.ValueInt64()))
+		changed = true
+	}
+
+	if !slices.Equal(plan.DefaultReactionEmoji, prior.DefaultReactionEmoji) {
+		if len(plan.DefaultReactionEmoji) > 0 {
+			reaction := plan.DefaultReactionEmoji[0]
+			edit.DefaultReactionEmoji = &discordgo.ForumDefaultReaction{
+				EmojiID:   reaction.EmojiId.ValueString(),
+				EmojiName: reaction.EmojiName.ValueString(),
+			}
+			changed = true
+		} else {
+			nulls["default_reaction_emoji"] = nil
+		}
+	}
+
+	if changed {
+		if _, err := c.ChannelEditComplex(channelId, edit, discordgo.WithContext(ctx)); err != nil {
+			return err
+		}
+	}
+
+	if len(nulls) > 0 {
+		endpoint := discordgo.EndpointChannel(channelId)
+		if _, err := c.RequestWithBucketID(http.MethodPatch, endpoint, nulls, endpoint, discordgo.WithContext(ctx)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var videoQualityModes = map[string]int{
+	"auto": 1,
+	"full": 2,
+}
+
+// channelSettings are the settings of a channel that discordgo doesn't decode.
+type channelSettings struct {
+	RTCRegion                  string `json:"rtc_region"`
+	VideoQualityMode           int    `json:"video_quality_mode"`
+	DefaultAutoArchiveDuration int    `json:"default_auto_archive_duration"`
+}
+
+// getChannelWithSettings fetches a channel together with the settings that
+// discordgo doesn't decode, from a single response. An empty voice region
+// means it's picked automatically.
+func getChannelWithSettings(c *discordgo.Session, ctx context.Context, channelId string) (*discordgo.Channel, *channelSettings, error) {
+	endpoint := discordgo.EndpointChannel(channelId)
+	body, err := c.RequestWithBucketID(http.MethodGet, endpoint, nil, endpoint, discordgo.WithContext(ctx))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var channel discordgo.Channel
+	if err := json.Unmarshal(body, &channel); err != nil {
+		return nil, nil, err
+	}
+	var settings channelSettings
+	if err := json.Unmarshal(body, &settings); err != nil {
+		return nil, nil, err
+	}
+
+	return &channel, &settings, nil
+}
+
+// updateChannelSettings applies the settings of text, news and voice channels
+// that can't be passed with ChannelEdit or when the channel is created, when
+// they differ from prior.
+func updateChannelSettings(c *discordgo.Session, ctx context.Context, channelId string, plan channelModel, prior channelModel) error {
+	settings := map[string]interface{}{}
+
+	channelType := plan.Type.ValueString()
+	switch channelType {
+	case "text", "news":
+		// The archive duration is left to Discord until it's set.
+		if v := plan.DefaultAutoArchiveDuration; !v.IsUnknown() && !v.IsNull() && !v.Equal(prior.DefaultAutoArchiveDuration) {
+			settings["default_auto_archive_duration"] = v.ValueInt64()
+		}
+		if !plan.DefaultThreadRateLimitPerUser.Equal(prior.DefaultThreadRateLimitPerUser) {
+			settings["default_thread_rate_limit_per_user"] = plan.DefaultThreadRateLimitPerUser.ValueInt64()
+		}
+	case "voice", "stage":
+		if !plan.RTCRegion.Equal(prior.RTCRegion) {
+			// Voice regions are picked automatically when they're nulled.
+			if v := plan.RTCRegion.ValueString(); v != "" {
+				settings["rtc_region"] = v
+			} else {
+				settings["rtc_region"] = nil
+			}
+		}
+		if channelType == "voice" && !plan.VideoQualityMode.Equal(prior.VideoQualityMode) {
+			settings["video_quality_mode"] = videoQualityModes[plan.VideoQualityMode.ValueString()]
+		}
+	}
+	if len(settings) == 0 {
+		return nil
+	}
+
+	endpoint := discordgo.EndpointChannel(channelId)
+	_, err := c.RequestWithBucketID(http.MethodPatch, endpoint, settings, endpoint, discordgo.WithContext(ctx))
+
+	return err
+}
+
+var threadTypes = map[string]discordgo.ChannelType{
+	"news":    discordgo.ChannelTypeGuildNewsThread,
+	"public":  discordgo.ChannelTypeGuildPublicThread,
+	"private": discordgo.ChannelTypeGuildPrivateThread,
+}
+
+func getTextThreadType(channelType discordgo.ChannelType) (string, bool) {
+	for k, v := range threadTypes {
+		if v == channelType {
+			return k, true
+		}
+	}
+
+	return "public", false
+}
+
+func isForumChannel(channel *discordgo.Channel) bool {
+	return channel.Type == discordgo.ChannelTypeGuildForum || channel.Type == discordgo.ChannelTypeGuildMedia
+}
@@ -18,6 +18,8 @@ func TestGetTextChannelType(t *testing.T) {
 		{id: 4, chType: "category", isHit: true},
 		{id: 5, chType: "news", isHit: true},
 		{id: 6, chType: "store", isHit: true},
+		{id: 13, chType: "stage", isHit: true},
+		{id: 15, chType: "forum", isHit: true},
 		// failure values
 		{id: 10, chType: "text", isHit: false},
 		{id: 100, chType: "text", isHit: false},
@@ -47,6 +49,8 @@ func TestGetDiscordChannelType(t *testing.T) {
 		{chType: 4, name: "category", isHit: true},
 		{chType: 5, name: "news", isHit: true},
 		{chType: 6, name: "store", isHit: true},
+		{chType: 13, name: "stage", isHit: true},
+		{chType: 15, name: "forum", isHit: true},
 		// failure values
 		{chType: 0, name: "lorem", isHit: false},
 		{chType: 0, name: "pesudo", isHit: false},
@@ -62,3 +66,30 @@ func TestGetDiscordChannelType(t *testing.T) {
 		}
 	}
 }
+
+func TestGetTextThreadType(t *testing.T) {
+	params := []struct {
+		id     uint
+		chType string
+		isHit  bool
+	}{
+		// success values
+		{id: 10, chType: "news", isHit: true},
+		{id: 11, chType: "public", isHit: true},
+		{id: 12, chType: "private", isHit: true},
+		// failure values
+		{id: 0, chType: "public", isHit: false},
+		{id: 15, chType: "public", isHit: false},
+	}
+
+	for _, p := range params {
+		chType := discordgo.ChannelType(p.id)
+		resChType, resIsHit := getTextThreadType(chType)
+		if p.chType != resChType {
+			t.Errorf("id: %v - chType Error: ex: %v, ac: %v", p.id, p.chType, resChType)
+		}
+		if p.isHit != resIsHit {
+			t.Errorf("id: %v - isHit Error: ex: %v, ac: %v", p.id, p.isHit, resIsHit)
+		}
+	}
+}
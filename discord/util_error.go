@@ -0,0 +1,73 @@
+package discord
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// notFoundCodes are the JSON error codes Discord answers with when the object
+// a request refers to doesn't exist, usually because it was deleted outside
+// of Terraform. Discord reuses the unknown command permissions code for
+// unknown AutoMod rules.
+var notFoundCodes = map[int]bool{
+	discordgo.ErrCodeUnknownApplication:                   true,
+	discordgo.ErrCodeUnknownChannel:                       true,
+	discordgo.ErrCodeUnknownGuild:                         true,
+	discordgo.ErrCodeUnknownInvite:                        true,
+	discordgo.ErrCodeUnknownMember:                        true,
+	discordgo.ErrCodeUnknownMessage:                       true,
+	discordgo.ErrCodeUnknownOverwrite:                     true,
+	discordgo.ErrCodeUnknownRole:                          true,
+	discordgo.ErrCodeUnknownUser:                          true,
+	discordgo.ErrCodeUnknownEmoji:                         true,
+	discordgo.ErrCodeUnknownWebhook:                       true,
+	discordgo.ErrCodeUnknownBan:                           true,
+	discordgo.ErrCodeUnknownSticker:                       true,
+	discordgo.ErrCodeUnknownApplicationCommand:            true,
+	discordgo.ErrCodeUnknownApplicationCommandPermissions: true,
+	discordgo.ErrCodeUnknownStageInstance:                 true,
+	discordgo.ErrCodeUnknownGuildWelcomeScreen:            true,
+	discordgo.ErrCodeUnknownGuildScheduledEvent:           true,
+}
+
+// notFoundError is returned when an object is missing from a list fetched
+// from Discord, for objects like roles that can't be fetched on their own.
+type notFoundError struct {
+	kind string
+	id   string
+}
+
+func (e *notFoundError) Error() string {
+	return fmt.Sprintf("%s %s not found", e.kind, e.id)
+}
+
+// getErrorCode returns the JSON error code of a failed Discord request, or 0
+// when err isn't a Discord error or didn't come with a code.
+func getErrorCode(err error) int {
+	var restErr *discordgo.RESTError
+	if !errors.As(err, &restErr) || restErr.Message == nil {
+		return 0
+	}
+
+	return restErr.Message.Code
+}
+
+// isNotFound reports whether err means the requested object doesn't exist.
+// Reads use it to remove vanished objects from state, while every other
+// error, like a missing permission or an outage, is still surfaced.
+func isNotFound(err error) bool {
+	var notFound *notFoundError
+	if errors.As(err, &notFound) {
+		return true
+	}
+
+	var restErr *discordgo.RESTError
+	if !errors.As(err, &restErr) || restErr.Response == nil || restErr.Response.StatusCode != http.StatusNotFound {
+		return false
+	}
+
+	return notFoundCodes[getErrorCode(err)]
+}
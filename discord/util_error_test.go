@@ -0,0 +1,45 @@
+package discord
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestIsNotFound(t *testing.T) {
+	restErr := func(status int, code int) error {
+		err := &discordgo.RESTError{Response: &http.Response{StatusCode: status}}
+		if code != 0 {
+			err.Message = &discordgo.APIErrorMessage{Code: code}
+		}
+
+		return err
+	}
+
+	params := []struct {
+		name     string
+		err      error
+		notFound bool
+	}{
+		{name: "unknown channel", err: restErr(http.StatusNotFound, discordgo.ErrCodeUnknownChannel), notFound: true},
+		{name: "unknown role", err: restErr(http.StatusNotFound, discordgo.ErrCodeUnknownRole), notFound: true},
+		{name: "unknown guild", err: restErr(http.StatusNotFound, discordgo.ErrCodeUnknownGuild), notFound: true},
+		{name: "unknown message", err: restErr(http.StatusNotFound, discordgo.ErrCodeUnknownMessage), notFound: true},
+		{name: "wrapped", err: fmt.Errorf("wrapped: %w", restErr(http.StatusNotFound, discordgo.ErrCodeUnknownMember)), notFound: true},
+		{name: "missing from list", err: &notFoundError{kind: "role", id: "123"}, notFound: true},
+		{name: "missing access", err: restErr(http.StatusForbidden, discordgo.ErrCodeMissingAccess), notFound: false},
+		{name: "unknown code in bad request", err: restErr(http.StatusBadRequest, discordgo.ErrCodeUnknownChannel), notFound: false},
+		{name: "not found without code", err: restErr(http.StatusNotFound, 0), notFound: false},
+		{name: "server error", err: restErr(http.StatusInternalServerError, 0), notFound: false},
+		{name: "network error", err: errors.New("connection reset by peer"), notFound: false},
+	}
+
+	for _, p := range params {
+		if res := isNotFound(p.err); res != p.notFound {
+			t.Errorf("%s: ex: %v, ac: %v", p.name, p.notFound, res)
+		}
+	}
+}
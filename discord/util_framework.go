@@ -0,0 +1,255 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/polds/imgbase64"
+)
+
+// setStrings returns the strings in set, and whether all of them are known.
+func setStrings(ctx context.Context, set types.Set) ([]string, bool) {
+	if set.IsUnknown() {
+		return nil, false
+	}
+
+	values := make([]types.String, 0, len(set.Elements()))
+	if diags := set.ElementsAs(ctx, &values, false); diags.HasError() {
+		return nil, false
+	}
+
+	res := make([]string, 0, len(values))
+	for _, v := range values {
+		if v.IsUnknown() {
+			return nil, false
+		}
+		res = append(res, v.ValueString())
+	}
+
+	return res, true
+}
+
+// mapStrings returns the strings in m, which is empty instead of nil when m is
+// null.
+func mapStrings(ctx context.Context, m types.Map) map[string]string {
+	res := make(map[string]string)
+	m.ElementsAs(ctx, &res, false)
+
+	return res
+}
+
+// setOfStrings returns values as a set, which is empty instead of null when
+// values is nil.
+func setOfStrings(ctx context.Context, values []string) (types.Set, diag.Diagnostics) {
+	if values == nil {
+		values = []string{}
+	}
+
+	return types.SetValueFrom(ctx, types.StringType, values)
+}
+
+// stringValue returns v as a value of an optional attribute, which is null
+// when Discord leaves it empty and it was null before.
+func stringValue(v string, prior types.String) types.String {
+	if v == "" && prior.IsNull() {
+		return types.StringNull()
+	}
+
+	return types.StringValue(v)
+}
+
+// int64Value is the same as stringValue, for integers.
+func int64Value(v int, prior types.Int64) types.Int64 {
+	if v == 0 && prior.IsNull() {
+		return types.Int64Null()
+	}
+
+	return types.Int64Value(int64(v))
+}
+
+// boolValue is the same as stringValue, for bools.
+func boolValue(v bool, prior types.Bool) types.Bool {
+	if !v && prior.IsNull() {
+		return types.BoolNull()
+	}
+
+	return types.BoolValue(v)
+}
+
+// setValue is the same as stringValue, for sets of strings.
+func setValue(ctx context.Context, values []string, prior types.Set) (types.Set, diag.Diagnostics) {
+	if len(values) == 0 && prior.IsNull() {
+		return types.SetNull(types.StringType), nil
+	}
+
+	return setOfStrings(ctx, values)
+}
+
+// mapValue is the same as stringValue, for maps of strings.
+func mapValue(ctx context.Context, values map[string]string, prior types.Map) (types.Map, diag.Diagnostics) {
+	if len(values) == 0 && prior.IsNull() {
+		return types.MapNull(types.StringType), nil
+	}
+	if values == nil {
+		values = map[string]string{}
+	}
+
+	return types.MapValueFrom(ctx, types.StringType, values)
+}
+
+// timeValue returns t as an RFC 3339 timestamp, which keeps prior while it's
+// the same time, since Discord hands timestamps back in UTC no matter which
+// offset they were set with.
+func timeValue(t time.Time, prior types.String) types.String {
+	if p, err := time.Parse(time.RFC3339, prior.ValueString()); err == nil && p.Equal(t) {
+		return prior
+	}
+
+	return types.StringValue(t.Format(time.RFC3339))
+}
+
+// buildImage returns the image to set from its data URI, or otherwise from
+// its remote URL.
+func buildImage(url types.String, dataURI types.String) string {
+	if v := dataURI.ValueString(); v != "" {
+		return v
+	}
+	if v := url.ValueString(); v != "" {
+		return imgbase64.FromRemote(v)
+	}
+
+	return ""
+}
+
+// upgradeFromSDK returns the state upgrader of a resource that was built on
+// the SDK with the same attributes and blocks as s. The SDK stores optional
+// values that aren't set as zero values, which are nulled so that they don't
+// show up as changes to the values that aren't set.
+func upgradeFromSDK(s schema.Schema) resource.StateUpgrader {
+	return resource.StateUpgrader{
+		PriorSchema: &s,
+		StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+			state, err := tftypes.Transform(req.State.Raw, func(p *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+				a, err := s.AttributeAtTerraformPath(ctx, p)
+				if err != nil || !a.IsOptional() || a.IsComputed() || !isZeroValue(v) {
+					return v, nil
+				}
+
+				return tftypes.NewValue(v.Type(), nil), nil
+			})
+			if err != nil {
+				resp.Diagnostics.AddError("Failed to upgrade the state from the SDK", err.Error())
+				return
+			}
+
+			resp.State.Raw = state
+		},
+	}
+}
+
+// isZeroValue reports whether v is the zero value of its type, or an empty
+// collection.
+func isZeroValue(v tftypes.Value) bool {
+	if !v.IsKnown() || v.IsNull() {
+		return false
+	}
+
+	switch t := v.Type(); {
+	case t.Is(tftypes.String):
+		var s string
+		return v.As(&s) == nil && s == ""
+	case t.Is(tftypes.Number):
+		var n big.Float
+		return v.As(&n) == nil && n.Sign() == 0
+	case t.Is(tftypes.Bool):
+		var b bool
+		return v.As(&b) == nil && !b
+	case t.Is(tftypes.List{}), t.Is(tftypes.Set{}):
+		var elements []tftypes.Value
+		return v.As(&elements) == nil && len(elements) == 0
+	case t.Is(tftypes.Map{}):
+		var elements map[string]tftypes.Value
+		return v.As(&elements) == nil && len(elements) == 0
+	}
+
+	return false
+}
+
+// useStateForUnknownOrNull is the same as
+// int64planmodifier.UseStateForUnknown, but also keeps prior values that are
+// null, like the settings of discord_channel that its type doesn't have.
+func useStateForUnknownOrNull() planmodifier.Int64 {
+	return useStateForUnknownOrNullModifier{}
+}
+
+type useStateForUnknownOrNullModifier struct{}
+
+func (m useStateForUnknownOrNullModifier) Description(ctx context.Context) string {
+	return "Once set, the value of this attribute in state will not change."
+}
+
+func (m useStateForUnknownOrNullModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m useStateForUnknownOrNullModifier) PlanModifyInt64(ctx context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
+	// The resource is being created.
+	if req.State.Raw.IsNull() {
+		return
+	}
+	if !req.PlanValue.IsUnknown() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	resp.PlanValue = req.StateValue
+}
+
+// isRFC3339Time validates that a string is an RFC 3339 timestamp.
+func isRFC3339Time() validator.String {
+	return parsesValidator{"an RFC 3339 timestamp", func(v string) error {
+		_, err := time.Parse(time.RFC3339, v)
+		return err
+	}}
+}
+
+// isNumber validates that a string is a number.
+func isNumber() validator.String {
+	return parsesValidator{"a number", func(v string) error {
+		_, err := strconv.ParseFloat(v, 64)
+		return err
+	}}
+}
+
+// parsesValidator validates that a string is something that parse accepts.
+type parsesValidator struct {
+	description string
+	parse       func(string) error
+}
+
+func (v parsesValidator) Description(ctx context.Context) string {
+	return "value must be " + v.description
+}
+
+func (v parsesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v parsesValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := v.parse(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Attribute Value", fmt.Sprintf("%s must be %s: %s", req.Path, v.description, err.Error()))
+	}
+}
@@ -33,3 +33,15 @@ func parseThreeIds(id string) (string, string, string, error) {
 func generateThreePartId(one string, two string, three string) string {
 	return fmt.Sprintf("%s:%s:%s", one, two, three)
 }
+
+// getTextValue returns the name that value is stored under in values, or an
+// empty string when it isn't there.
+func getTextValue(values map[string]int, value int) string {
+	for k, v := range values {
+		if v == value {
+			return k
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,64 @@
+package discord
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/textproto"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"golang.org/x/net/context"
+)
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// buildMultipartBody encodes fields and file as a multipart/form-data body, with
+// the file sent in the `file` part. It returns the content type to send it with.
+func buildMultipartBody(fields map[string]string, file *discordgo.File) (string, []byte, error) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	for k, v := range fields {
+		if err := writer.WriteField(k, v); err != nil {
+			return "", nil, err
+		}
+	}
+
+	if file != nil {
+		h := make(textproto.MIMEHeader)
+		h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename="%s"`, quoteEscaper.Replace(file.Name)))
+		contentType := file.ContentType
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		h.Set("Content-Type", contentType)
+
+		part, err := writer.CreatePart(h)
+		if err != nil {
+			return "", nil, err
+		}
+		if _, err := io.Copy(part, file.Reader); err != nil {
+			return "", nil, err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return "", nil, err
+	}
+
+	return writer.FormDataContentType(), body.Bytes(), nil
+}
+
+// requestMultipart makes a request with a multipart/form-data body. discordgo only
+// sends files alongside a `payload_json` part, which endpoints that take plain
+// form fields (like sticker uploads) don't accept.
+func requestMultipart(ctx context.Context, client *discordgo.Session, method string, endpoint string, fields map[string]string, file *discordgo.File) ([]byte, error) {
+	contentType, body, err := buildMultipartBody(fields, file)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.RequestWithLockedBucket(method, endpoint, contentType, body, client.Ratelimiter.LockBucket(endpoint), 0, discordgo.WithContext(ctx))
+}
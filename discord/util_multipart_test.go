@@ -0,0 +1,62 @@
+package discord
+
+import (
+	"bytes"
+	"io"
+	"mime"
+	"mime/multipart"
+	"strings"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestBuildMultipartBody(t *testing.T) {
+	contentType, body, err := buildMultipartBody(map[string]string{"name": "wave", "tags": "wave"}, &discordgo.File{
+		Name:        `my "sticker".png`,
+		ContentType: "image/png",
+		Reader:      strings.NewReader("png data"),
+	})
+	if err != nil {
+		t.Fatalf("buildMultipartBody returned error: %s", err)
+	}
+
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil || mediaType != "multipart/form-data" {
+		t.Fatalf("unexpected content type %s", contentType)
+	}
+
+	parts := map[string]string{}
+	reader := multipart.NewReader(bytes.NewReader(body), params["boundary"])
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("failed to read part: %s", err)
+		}
+		value, _ := io.ReadAll(part)
+		parts[part.FormName()] = string(value)
+
+		if part.FormName() == "file" {
+			if part.FileName() != `my "sticker".png` {
+				t.Errorf("file name = %s, want %s", part.FileName(), `my "sticker".png`)
+			}
+			if part.Header.Get("Content-Type") != "image/png" {
+				t.Errorf("file content type = %s, want image/png", part.Header.Get("Content-Type"))
+			}
+		}
+	}
+
+	tests := map[string]string{
+		"name": "wave",
+		"tags": "wave",
+		"file": "png data",
+	}
+	for name, want := range tests {
+		if parts[name] != want {
+			t.Errorf("part %s = %q, want %q", name, parts[name], want)
+		}
+	}
+}
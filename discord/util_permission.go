@@ -0,0 +1,87 @@
+package discord
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// getPermissionNames returns the names of the permissions set in bits. Bits
+// of permissions that aren't in permissions are left out.
+func getPermissionNames(bits int64) []string {
+	names := make([]string, 0)
+	for name, bit := range permissions {
+		if bits&bit == bit {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func getPermissionBits(names []string) int64 {
+	var bits int64
+	for _, name := range names {
+		bits |= permissions[name]
+	}
+
+	return bits
+}
+
+// getAllPermissionNames returns the names of every permission, sorted.
+func getAllPermissionNames() []string {
+	names := make([]string, 0, len(permissions))
+	for name := range permissions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// getPermissionNamesAttribute returns the schema of a set of permission names,
+// that is an alternative to the permission bits in bitsKey.
+func getPermissionNamesAttribute(bitsKey string, description string) schema.SetAttribute {
+	return schema.SetAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+		Computed:    true,
+		Validators: []validator.Set{
+			setvalidator.ConflictsWith(path.MatchRoot(bitsKey)),
+			setvalidator.ValueStringsAre(stringvalidator.OneOf(getAllPermissionNames()...)),
+		},
+		Description: description,
+	}
+}
+
+// planPermissions plans the permission bits and the permission names from
+// whichever of them is configured, so a change of one shows up in the other
+// too.
+func planPermissions(ctx context.Context, configBits types.Int64, configNames types.Set, bits *types.Int64, names *types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch {
+	case !configNames.IsNull():
+		v, known := setStrings(ctx, configNames)
+		if !known {
+			*bits = types.Int64Unknown()
+			break
+		}
+		*bits = types.Int64Value(getPermissionBits(v))
+	case configBits.IsUnknown():
+		*names = types.SetUnknown(types.StringType)
+	default:
+		*bits = types.Int64Value(configBits.ValueInt64())
+		*names, diags = setOfStrings(ctx, getPermissionNames(configBits.ValueInt64()))
+	}
+
+	return diags
+}
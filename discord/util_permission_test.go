@@ -0,0 +1,42 @@
+package discord
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGetPermissionNames(t *testing.T) {
+	params := []struct {
+		bits  int64
+		names []string
+	}{
+		{bits: 0, names: []string{}},
+		{bits: 0x400, names: []string{"view_channel"}},
+		{bits: 0x2000 | 0x800 | 0x400, names: []string{"manage_messages", "send_messages", "view_channel"}},
+		// unknown bits are left out
+		{bits: 0x8 | 0x800000000000000, names: []string{"administrator"}},
+	}
+
+	for _, p := range params {
+		if names := getPermissionNames(p.bits); !reflect.DeepEqual(names, p.names) {
+			t.Errorf("bits: %#x - ex: %v, ac: %v", p.bits, p.names, names)
+		}
+	}
+}
+
+func TestGetPermissionBits(t *testing.T) {
+	params := []struct {
+		names []string
+		bits  int64
+	}{
+		{names: []string{}, bits: 0},
+		{names: []string{"view_channel"}, bits: 0x400},
+		{names: []string{"view_channel", "send_messages", "manage_messages"}, bits: 0x2000 | 0x800 | 0x400},
+	}
+
+	for _, p := range params {
+		if bits := getPermissionBits(p.names); bits != p.bits {
+			t.Errorf("names: %v - ex: %#x, ac: %#x", p.names, p.bits, bits)
+		}
+	}
+}
@@ -2,9 +2,9 @@ package discord
 
 import (
 	"context"
+	"sort"
 
 	"github.com/bwmarrin/discordgo"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 )
 
 type Role struct {
@@ -57,31 +57,30 @@ func findRoleById(array []*discordgo.Role, id string) *discordgo.Role {
 	return nil
 }
 
-func reorderRoles(ctx context.Context, m interface{}, serverId string, role *discordgo.Role, position int) (bool, diag.Diagnostics) {
-	client := m.(*Context).Session
-
+func getRole(ctx context.Context, client *discordgo.Session, serverId string, roleId string) (*discordgo.Role, error) {
 	roles, err := client.GuildRoles(serverId, discordgo.WithContext(ctx))
 	if err != nil {
-		return false, diag.Errorf("Failed to fetch roles: %s", err.Error())
-	}
-	index, exists := findRoleIndex(roles, role)
-	if !exists {
-		return false, diag.Errorf("Role somehow does not exists")
+		return nil, err
 	}
 
-	moveRole(roles, index, position)
-
-	if roles, err = client.GuildRoleReorder(serverId, roles, discordgo.WithContext(ctx)); err != nil {
-		return false, diag.Errorf("Failed to re-order roles: %s", err.Error())
+	if role := findRoleById(roles, roleId); role != nil {
+		return role, nil
 	}
 
-	return true, nil
+	return nil, &notFoundError{kind: "role", id: roleId}
 }
 
-func getRole(ctx context.Context, client *discordgo.Session, serverId string, roleId string) (*discordgo.Role, error) {
-	if roles, err := client.GuildRoles(serverId, discordgo.WithContext(ctx)); err != nil {
-		return nil, err
-	} else {
-		return findRoleById(roles, roleId), nil
-	}
+// sortRoles sorts roles from the top of the hierarchy down, the way Discord
+// shows them. Roles with the same position are ordered by their ID.
+func sortRoles(roles []*discordgo.Role) {
+	sort.SliceStable(roles, func(i, j int) bool {
+		if roles[i].Position != roles[j].Position {
+			return roles[i].Position > roles[j].Position
+		}
+		if len(roles[i].ID) != len(roles[j].ID) {
+			return len(roles[i].ID) < len(roles[j].ID)
+		}
+
+		return roles[i].ID < roles[j].ID
+	})
 }
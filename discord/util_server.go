@@ -0,0 +1,187 @@
+package discord
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// communityFeatures are the features of a server that can be managed with
+// `features`.
+var communityFeatures = []string{"COMMUNITY", "DISCOVERABLE"}
+
+// serverSettings are the settings of a server that discordgo doesn't decode.
+type serverSettings struct {
+	SafetyAlertsChannelID     string   `json:"safety_alerts_channel_id"`
+	PremiumProgressBarEnabled bool     `json:"premium_progress_bar_enabled"`
+	Features                  []string `json:"features"`
+}
+
+// getServerWithSettings fetches a server together with the settings that
+// discordgo doesn't decode, from a single response.
+func getServerWithSettings(c *discordgo.Session, ctx context.Context, serverId string) (*discordgo.Guild, *serverSettings, error) {
+	endpoint := discordgo.EndpointGuild(serverId)
+	body, err := c.RequestWithBucketID(http.MethodGet, endpoint, nil, endpoint, discordgo.WithContext(ctx))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var server discordgo.Guild
+	if err := json.Unmarshal(body, &server); err != nil {
+		return nil, nil, err
+	}
+	var settings serverSettings
+	if err := json.Unmarshal(body, &settings); err != nil {
+		return nil, nil, err
+	}
+
+	return &server, &settings, nil
+}
+
+// getServerSettings fetches the settings of a server that discordgo doesn't
+// decode.
+func getServerSettings(c *discordgo.Session, ctx context.Context, serverId string) (*serverSettings, error) {
+	_, settings, err := getServerWithSettings(c, ctx, serverId)
+
+	return settings, err
+}
+
+// serverSettingDefaults are the values Discord gives the settings of a server
+// when they're reset.
+var serverSettingDefaults = map[string]interface{}{
+	"system_channel_flags":         0,
+	"rules_channel_id":             nil,
+	"public_updates_channel_id":    nil,
+	"safety_alerts_channel_id":     nil,
+	"preferred_locale":             "en-US",
+	"description":                  nil,
+	"premium_progress_bar_enabled": false,
+}
+
+// setServerSetting refreshes a setting only while it's managed, so that
+// settings that aren't configured stay out of state and settings that leave
+// the configuration show up as removed. A setting is managed while it's not
+// null. Zero values count too, so drift from an explicit `0` or `false` shows
+// up.
+func setServerSetting[T attr.Value](setting *T, value T) {
+	if !(*setting).IsNull() {
+		*setting = value
+	}
+}
+
+// updateServerSettings applies the settings of a server that changed from
+// prior to plan, which GuildParams leaves out when they're empty, or doesn't
+// have at all. Settings that were removed from the configuration are reset to
+// Discord's defaults.
+func updateServerSettings(c *discordgo.Session, ctx context.Context, server *discordgo.Guild, plan serverModel, prior serverModel) error {
+	settings := map[string]interface{}{}
+	for key, v := range map[string]struct {
+		plan, prior attr.Value
+		value       interface{}
+	}{
+		"system_channel_flags":         {plan.SystemChannelFlags, prior.SystemChannelFlags, plan.SystemChannelFlags.ValueInt64()},
+		"rules_channel_id":             {plan.RulesChannelId, prior.RulesChannelId, plan.RulesChannelId.ValueString()},
+		"public_updates_channel_id":    {plan.PublicUpdatesChannelId, prior.PublicUpdatesChannelId, plan.PublicUpdatesChannelId.ValueString()},
+		"safety_alerts_channel_id":     {plan.SafetyAlertsChannelId, prior.SafetyAlertsChannelId, plan.SafetyAlertsChannelId.ValueString()},
+		"preferred_locale":             {plan.PreferredLocale, prior.PreferredLocale, plan.PreferredLocale.ValueString()},
+		"description":                  {plan.Description, prior.Description, plan.Description.ValueString()},
+		"premium_progress_bar_enabled": {plan.PremiumProgressBarEnabled, prior.PremiumProgressBarEnabled, plan.PremiumProgressBarEnabled.ValueBool()},
+	} {
+		if v.plan.Equal(v.prior) {
+			continue
+		}
+		if v.plan.IsNull() {
+			settings[key] = serverSettingDefaults[key]
+		} else {
+			settings[key] = v.value
+		}
+	}
+
+	// GuildParams leaves these out when they're 0, which is their default.
+	if !plan.DefaultMessageNotifications.Equal(prior.DefaultMessageNotifications) {
+		settings["default_message_notifications"] = plan.DefaultMessageNotifications.ValueInt64()
+	}
+	if !plan.ExplicitContentFilter.Equal(prior.ExplicitContentFilter) {
+		settings["explicit_content_filter"] = plan.ExplicitContentFilter.ValueInt64()
+	}
+
+	if !plan.Features.Equal(prior.Features) || !plan.InvitesDisabled.Equal(prior.InvitesDisabled) || !plan.RaidAlertsDisabled.Equal(prior.RaidAlertsDisabled) {
+		features := buildServerFeatures(ctx, server, plan, prior)
+		// Enabling the community needs its channels in the same request.
+		if contains(features, "COMMUNITY") {
+			settings["rules_channel_id"] = plan.RulesChannelId.ValueString()
+			settings["public_updates_channel_id"] = plan.PublicUpdatesChannelId.ValueString()
+		}
+		settings["features"] = features
+	}
+
+	if len(settings) > 0 {
+		endpoint := discordgo.EndpointGuild(server.ID)
+		if _, err := c.RequestWithBucketID(http.MethodPatch, endpoint, settings, endpoint, discordgo.WithContext(ctx)); err != nil {
+			return err
+		}
+	}
+
+	if !plan.MfaLevel.Equal(prior.MfaLevel) {
+		endpoint := discordgo.EndpointGuild(server.ID) + "/mfa"
+		if _, err := c.RequestWithBucketID(http.MethodPost, endpoint, map[string]interface{}{"level": plan.MfaLevel.ValueInt64()}, endpoint, discordgo.WithContext(ctx)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// serverFeatures returns the features of a server as strings.
+func serverFeatures(server *discordgo.Guild) []string {
+	features := make([]string, 0, len(server.Features))
+	for _, feature := range server.Features {
+		features = append(features, string(feature))
+	}
+
+	return features
+}
+
+// buildServerFeatures returns the features of a server with the ones that
+// changed from prior to plan enabled or disabled. Features that aren't managed
+// here, such as WELCOME_SCREEN_ENABLED which belongs to
+// discord_welcome_screen, are kept as they are, and Discord ignores the
+// features that can't be changed.
+func buildServerFeatures(ctx context.Context, server *discordgo.Guild, plan serverModel, prior serverModel) []string {
+	enabled := make(map[string]bool)
+	for _, feature := range serverFeatures(server) {
+		enabled[feature] = true
+	}
+	if !plan.Features.Equal(prior.Features) {
+		for _, feature := range communityFeatures {
+			enabled[feature] = false
+		}
+		features, _ := setStrings(ctx, plan.Features)
+		for _, feature := range features {
+			enabled[feature] = true
+		}
+	}
+	for feature, v := range map[string]struct{ plan, prior types.Bool }{
+		"INVITES_DISABLED":     {plan.InvitesDisabled, prior.InvitesDisabled},
+		"RAID_ALERTS_DISABLED": {plan.RaidAlertsDisabled, prior.RaidAlertsDisabled},
+	} {
+		if !v.plan.Equal(v.prior) {
+			enabled[feature] = v.plan.ValueBool()
+		}
+	}
+
+	features := make([]string, 0, len(enabled))
+	for feature, ok := range enabled {
+		if ok {
+			features = append(features, feature)
+		}
+	}
+	sort.Strings(features)
+
+	return features
+}
@@ -0,0 +1,12 @@
+data "discord_bans" "partner" {
+  server_id = var.partner_server_id
+}
+
+# Mirror the partner server's bans
+resource "discord_ban" "mirrored" {
+  for_each = data.discord_bans.partner.user_ids
+
+  server_id = var.server_id
+  user_id   = each.value
+  reason    = "Banned on partner server"
+}
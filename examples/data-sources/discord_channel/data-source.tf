@@ -0,0 +1,9 @@
+data "discord_channel" "general" {
+  server_id = var.server_id
+  name      = "general"
+  type      = "text"
+}
+
+output "general_channel_id" {
+  value = data.discord_channel.general.id
+}
@@ -0,0 +1,13 @@
+data "discord_channels" "voice" {
+  server_id = var.server_id
+  type      = "voice"
+}
+
+resource "discord_channel_permission" "muted" {
+  for_each = { for c in data.discord_channels.voice.channels : c.name => c.channel_id }
+
+  channel_id   = each.value
+  type         = "role"
+  overwrite_id = var.muted_role_id
+  deny_names   = ["speak"]
+}
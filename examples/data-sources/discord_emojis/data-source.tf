@@ -0,0 +1,11 @@
+data "discord_emojis" "emojis" {
+  server_id = var.server_id
+}
+
+resource "discord_forum_channel" "support" {
+  // ...
+  available_tags {
+    name     = "solved"
+    emoji_id = data.discord_emojis.emojis.emojis["solved"]
+  }
+}
@@ -0,0 +1,8 @@
+data "discord_sticker" "wave" {
+  server_id = var.server_id
+  name      = "wave"
+}
+
+output "wave_sticker_id" {
+  value = data.discord_sticker.wave.id
+}
@@ -0,0 +1,7 @@
+data "discord_vanity_url" "example" {
+  server_id = var.server_id
+}
+
+output "invite_url" {
+  value = data.discord_vanity_url.example.url
+}
@@ -0,0 +1,4 @@
+# Global commands
+terraform import discord_application_command.example "<application id>:<command id>"
+# Server commands
+terraform import discord_application_command.example "<application id>:<server id>:<command id>"
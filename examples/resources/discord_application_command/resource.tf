@@ -0,0 +1,47 @@
+resource "discord_application_command" "remind" {
+  name        = "remind"
+  description = "Set a reminder"
+  contexts    = ["guild", "bot_dm"]
+
+  description_localizations = {
+    de = "Eine Erinnerung setzen"
+  }
+
+  option {
+    type        = "sub_command"
+    name        = "in"
+    description = "Remind you after a while"
+
+    option {
+      type        = "integer"
+      name        = "amount"
+      description = "How long to wait"
+      required    = true
+      min_value   = 1
+      max_value   = 60
+    }
+
+    option {
+      type        = "string"
+      name        = "unit"
+      description = "Unit of the amount"
+      required    = true
+
+      choice {
+        name  = "Minutes"
+        value = "minutes"
+      }
+      choice {
+        name  = "Hours"
+        value = "hours"
+      }
+    }
+  }
+}
+
+resource "discord_application_command" "report" {
+  server_id                  = var.server_id
+  type                       = "message"
+  name                       = "Report to moderators"
+  default_member_permissions = data.discord_permission.send_messages.allow_bits
+}
@@ -0,0 +1 @@
+terraform import discord_application_command_permissions.example "<application id>:<server id>:<command id>"
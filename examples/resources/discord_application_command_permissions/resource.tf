@@ -0,0 +1,17 @@
+resource "discord_application_command_permissions" "ban" {
+  server_id    = var.server_id
+  command_id   = discord_application_command.ban.id
+  bearer_token = var.admin_bearer_token
+
+  permission {
+    type = "role"
+    id   = discord_role.moderator.id
+  }
+
+  # Nobody else, through @everyone
+  permission {
+    type       = "role"
+    id         = var.server_id
+    permission = false
+  }
+}
@@ -0,0 +1 @@
+terraform import discord_automod_rule.example "<server id>:<rule id>"
@@ -0,0 +1,38 @@
+resource "discord_automod_rule" "bad_words" {
+  server_id    = var.server_id
+  name         = "Block bad words"
+  trigger_type = "keyword"
+
+  trigger_metadata {
+    keyword_filter = ["cursed*", "*badword*"]
+    allow_list     = ["cursed image"]
+  }
+
+  action {
+    type           = "block_message"
+    custom_message = "Please keep it civil."
+  }
+
+  action {
+    type       = "send_alert_message"
+    channel_id = discord_text_channel.mod_log.id
+  }
+
+  exempt_roles = [discord_role.moderator.id]
+}
+
+resource "discord_automod_rule" "mention_spam" {
+  server_id    = var.server_id
+  name         = "Mention spam"
+  trigger_type = "mention_spam"
+
+  trigger_metadata {
+    mention_total_limit             = 10
+    mention_raid_protection_enabled = true
+  }
+
+  action {
+    type             = "timeout"
+    duration_seconds = 3600
+  }
+}
@@ -0,0 +1,12 @@
+variable "banned_user_ids" {
+  type = set(string)
+}
+
+resource "discord_ban" "shared" {
+  for_each = var.banned_user_ids
+
+  server_id              = var.server_id
+  user_id                = each.value
+  reason                 = "On the shared ban list"
+  delete_message_seconds = 86400
+}
@@ -0,0 +1 @@
+terraform import discord_channel.example "<channel id>"
@@ -0,0 +1,15 @@
+resource "discord_channel" "announcements" {
+  server_id                = var.server_id
+  type                     = "news"
+  name                     = "announcements"
+  topic                    = "News about the server"
+  sync_perms_with_category = false
+}
+
+resource "discord_channel" "lounge" {
+  server_id                = var.server_id
+  type                     = "voice"
+  name                     = "Lounge"
+  user_limit               = 10
+  sync_perms_with_category = false
+}
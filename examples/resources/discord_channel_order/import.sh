@@ -0,0 +1 @@
+terraform import discord_channel_order.example "<server id>"
@@ -0,0 +1,23 @@
+resource "discord_channel_order" "layout" {
+  server_id = var.server_id
+
+  channel_ids = [
+    discord_text_channel.rules.id,
+  ]
+
+  category {
+    category_id = discord_category_channel.chatting.id
+    channel_ids = [
+      discord_text_channel.general.id,
+      discord_voice_channel.general.id,
+    ]
+  }
+
+  category {
+    category_id      = discord_category_channel.staff.id
+    lock_permissions = true
+    channel_ids = [
+      discord_text_channel.moderation.id,
+    ]
+  }
+}
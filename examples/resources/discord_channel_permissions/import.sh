@@ -0,0 +1 @@
+terraform import discord_channel_permissions.example "<channel id>"
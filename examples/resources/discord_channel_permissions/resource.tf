@@ -0,0 +1,15 @@
+resource "discord_channel_permissions" "chatting" {
+  channel_id = var.channel_id
+
+  overwrite {
+    type         = "role"
+    overwrite_id = var.server_id
+    deny         = 1024
+  }
+
+  overwrite {
+    type         = "role"
+    overwrite_id = var.role_id
+    allow        = data.discord_permission.chatting.allow_bits
+  }
+}
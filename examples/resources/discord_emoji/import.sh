@@ -0,0 +1 @@
+terraform import discord_emoji.example "<server id>:<emoji id>"
@@ -0,0 +1,6 @@
+resource "discord_emoji" "party_parrot" {
+  server_id = var.server_id
+  name      = "party_parrot"
+  image     = "emoji/party_parrot.gif"
+  roles     = [discord_role.members.id]
+}
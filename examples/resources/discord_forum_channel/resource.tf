@@ -0,0 +1,23 @@
+resource "discord_forum_channel" "support" {
+  name      = "support"
+  server_id = var.server_id
+  position  = 0
+  topic     = "Search for existing posts before opening a new one."
+
+  default_sort_order   = "latest_activity"
+  default_forum_layout = "list_view"
+
+  available_tags {
+    name       = "bug"
+    emoji_name = "🐛"
+  }
+
+  available_tags {
+    name      = "resolved"
+    moderated = true
+  }
+
+  default_reaction_emoji {
+    emoji_name = "👍"
+  }
+}
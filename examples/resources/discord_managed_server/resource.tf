@@ -1,3 +1,13 @@
 resource "discord_managed_server" "my_server" {
   server_id = "my-server-id"
 }
+
+resource "discord_managed_server" "community" {
+  server_id                 = "my-community-server-id"
+  verification_level        = 1
+  explicit_content_filter   = 2
+  rules_channel_id          = discord_text_channel.rules.id
+  public_updates_channel_id = discord_text_channel.moderators.id
+  features                  = ["COMMUNITY"]
+  raid_alerts_disabled      = false
+}
@@ -1,23 +1,24 @@
 resource "discord_message" "hello_world" {
   channel_id = var.channel_id
 
-  embed {
+  embed = {
     title = "Hello World"
 
-    footer {
+    footer = {
       text = "I'm awesome"
     }
 
-    fields {
-      name   = "foo"
-      value  = "bar"
-      inline = true
-    }
-
-    fields {
-      name   = "bar"
-      value  = "baz"
-      inline = false
-    }
+    fields = [
+      {
+        name   = "foo"
+        value  = "bar"
+        inline = true
+      },
+      {
+        name   = "bar"
+        value  = "baz"
+        inline = false
+      },
+    ]
   }
 }
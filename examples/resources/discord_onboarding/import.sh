@@ -0,0 +1 @@
+terraform import discord_onboarding.example "<server id>"
@@ -0,0 +1,24 @@
+resource "discord_onboarding" "example" {
+  server_id           = var.server_id
+  default_channel_ids = [discord_text_channel.general.id, discord_text_channel.announcements.id]
+
+  prompt {
+    title    = "Which teams are you on?"
+    required = true
+
+    option {
+      title       = "Perception"
+      description = "Cameras, lidar and everything in between"
+      role_ids    = [discord_role.perception.id]
+      channel_ids = [discord_text_channel.perception.id]
+      emoji_name  = "👀"
+    }
+
+    option {
+      title       = "Controls"
+      role_ids    = [discord_role.controls.id]
+      channel_ids = [discord_text_channel.controls.id]
+      emoji_name  = "🕹️"
+    }
+  }
+}
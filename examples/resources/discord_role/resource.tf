@@ -7,3 +7,10 @@ resource "discord_role" "moderator" {
   mentionable = true
   position    = 5
 }
+
+resource "discord_role" "member" {
+  server_id        = var.server_id
+  name             = "Member"
+  permission_names = ["view_channel", "send_messages", "read_message_history"]
+  position         = 4
+}
@@ -0,0 +1 @@
+terraform import discord_role_order.example "<server id>"
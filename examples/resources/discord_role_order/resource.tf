@@ -0,0 +1,8 @@
+resource "discord_role_order" "hierarchy" {
+  server_id = var.server_id
+  role_ids = [
+    discord_role.admin.id,
+    discord_role.moderator.id,
+    discord_role.member.id,
+  ]
+}
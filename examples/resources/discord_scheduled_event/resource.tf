@@ -0,0 +1,18 @@
+resource "discord_scheduled_event" "town_hall" {
+  server_id   = var.server_id
+  name        = "Town Hall"
+  description = "Our weekly town hall."
+  entity_type = "stage"
+  channel_id  = discord_stage_channel.town_hall.id
+  start_time  = "2024-06-07T18:00:00Z"
+}
+
+resource "discord_scheduled_event" "meetup" {
+  server_id       = var.server_id
+  name            = "Summer Meetup"
+  entity_type     = "external"
+  location        = "Central Park"
+  start_time      = "2024-07-20T14:00:00+02:00"
+  end_time        = "2024-07-20T18:00:00+02:00"
+  cover_image_url = "https://example.com/meetup.png"
+}
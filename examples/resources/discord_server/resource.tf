@@ -1,4 +1,8 @@
 resource "discord_server" "my_server" {
   name   = "My Awesome Server"
   region = "us-west"
+
+  // Don't post a message when someone boosts the server.
+  system_channel_flags         = 2
+  premium_progress_bar_enabled = true
 }
@@ -0,0 +1 @@
+terraform import discord_server_widget.example "<server id>"
@@ -0,0 +1,8 @@
+resource "discord_server_widget" "example" {
+  server_id  = var.server_id
+  channel_id = discord_text_channel.welcome.id
+}
+
+output "widget_json_url" {
+  value = discord_server_widget.example.json_url
+}
@@ -0,0 +1,6 @@
+resource "discord_stage_channel" "town_hall" {
+  name       = "Town Hall"
+  server_id  = var.server_id
+  position   = 0
+  rtc_region = "rotterdam"
+}
@@ -0,0 +1,4 @@
+resource "discord_stage_instance" "town_hall" {
+  channel_id = discord_stage_channel.town_hall.id
+  topic      = "Weekly town hall"
+}
@@ -0,0 +1 @@
+terraform import discord_sticker.example "<server id>:<sticker id>"
@@ -0,0 +1,7 @@
+resource "discord_sticker" "wave" {
+  server_id   = var.server_id
+  name        = "wave"
+  description = "Say hi to the team."
+  tags        = "wave"
+  file        = "stickers/wave.png"
+}
@@ -0,0 +1,19 @@
+resource "discord_thread" "announcements_discussion" {
+  channel_id = discord_news_channel.announcements.id
+  name       = "announcements-discussion"
+}
+
+resource "discord_thread" "faq" {
+  channel_id   = discord_forum_channel.support.id
+  name         = "FAQ"
+  locked       = true
+  applied_tags = [discord_forum_channel.support.available_tags[0].id]
+
+  message {
+    content = "Please read this before opening a new post."
+
+    embed = {
+      title = "Frequently asked questions"
+    }
+  }
+}
@@ -0,0 +1 @@
+terraform import discord_vanity_url.example "<server id>"
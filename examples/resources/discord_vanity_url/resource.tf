@@ -0,0 +1,4 @@
+resource "discord_vanity_url" "example" {
+  server_id = var.server_id
+  code      = "watonomous"
+}
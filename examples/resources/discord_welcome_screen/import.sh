@@ -0,0 +1 @@
+terraform import discord_welcome_screen.example "<server id>"
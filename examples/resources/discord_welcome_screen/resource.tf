@@ -0,0 +1,16 @@
+resource "discord_welcome_screen" "example" {
+  server_id   = var.server_id
+  description = "The home of our robotics team."
+
+  welcome_channel {
+    channel_id  = discord_text_channel.rules.id
+    description = "Read the rules first"
+    emoji       = "📜"
+  }
+
+  welcome_channel {
+    channel_id  = discord_text_channel.general.id
+    description = "Say hi to everyone"
+    emoji       = "👋"
+  }
+}
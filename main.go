@@ -2,8 +2,9 @@ package main
 
 import (
 	"flag"
+	"log"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
 
 	"github.com/lucky3028/discord-terraform/discord"
 )
@@ -21,8 +22,13 @@ func main() {
 
 	flag.BoolVar(&debugMode, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
-	plugin.Serve(&plugin.ServeOpts{
-		ProviderFunc: discord.Provider(version),
-		Debug:        debugMode,
-	})
+
+	var serveOpts []tf6server.ServeOpt
+	if debugMode {
+		serveOpts = append(serveOpts, tf6server.WithManagedDebug())
+	}
+
+	if err := tf6server.Serve("registry.terraform.io/lucky3028/discord", discord.ProviderServer(version), serveOpts...); err != nil {
+		log.Fatal(err)
+	}
 }